@@ -1,11 +1,9 @@
 package accesscontrol
 
 import (
-	"errors"
+	"strconv"
 
 	"github.com/neilZon/workout-logger-api/accesscontroller"
-	"github.com/neilZon/workout-logger-api/database"
-	"github.com/neilZon/workout-logger-api/utils"
 	"gorm.io/gorm"
 )
 
@@ -13,40 +11,116 @@ type AccessController struct {
 	DB *gorm.DB
 }
 
-// CanAccessExercise implements accesscontroller.AccessControllerService
-func (*AccessController) CanAccessExercise(userId string, exerciseId string) error {
-	panic("unimplemented")
+// owner is the user a checked row belongs to
+type owner struct {
+	ID     uint
+	UserID uint
 }
 
 func (ac *AccessController) CanAccessWorkoutRoutine(userId string, workoutRoutineId string) error {
-	workoutRoutine, err := database.GetWorkoutRoutine(ac.DB, workoutRoutineId)
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return err
-	}
-
-	if utils.UIntToString(workoutRoutine.UserID) != userId {
-		return errors.New("Access Denied")
-	}
-	return nil
+	return ac.CanAccessWorkoutRoutines(userId, []string{workoutRoutineId})
 }
 
 func (ac *AccessController) CanAccessWorkoutSession(userId string, workoutSessionId string) error {
-	workoutSession, err := database.GetWorkoutSession(ac.DB, workoutSessionId)
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return err
-	}
-	if utils.UIntToString(workoutSession.UserID) != userId {
-		return errors.New("Access Denied")
-	}
-	return nil
+	return ac.CanAccessWorkoutSessions(userId, []string{workoutSessionId})
+}
+
+func (ac *AccessController) CanAccessExerciseRoutine(userId string, exerciseRoutineId string) error {
+	return ac.CanAccessExerciseRoutines(userId, []string{exerciseRoutineId})
+}
+
+func (ac *AccessController) CanAccessExercise(userId string, exerciseId string) error {
+	return ac.CanAccessExercises(userId, []string{exerciseId})
+}
+
+func (ac *AccessController) CanAccessSetEntry(userId string, setEntryId string) error {
+	return ac.CanAccessSetEntries(userId, []string{setEntryId})
+}
+
+func (ac *AccessController) CanAccessWorkoutRoutines(userId string, workoutRoutineIds []string) error {
+	query := ac.DB.Table("workout_routines").
+		Select("workout_routines.id, workout_routines.user_id").
+		Where("workout_routines.deleted_at IS NULL")
+	return check(query, "workout_routines", userId, workoutRoutineIds)
+}
+
+func (ac *AccessController) CanAccessWorkoutSessions(userId string, workoutSessionIds []string) error {
+	query := ac.DB.Table("workout_sessions").
+		Select("workout_sessions.id, workout_sessions.user_id").
+		Where("workout_sessions.deleted_at IS NULL")
+	return check(query, "workout_sessions", userId, workoutSessionIds)
+}
+
+func (ac *AccessController) CanAccessExerciseRoutines(userId string, exerciseRoutineIds []string) error {
+	query := ac.DB.Table("exercise_routines").
+		Select("exercise_routines.id, workout_routines.user_id").
+		Joins("JOIN workout_routines ON workout_routines.id = exercise_routines.workout_routine_id AND workout_routines.deleted_at IS NULL").
+		Where("exercise_routines.deleted_at IS NULL")
+	return check(query, "exercise_routines", userId, exerciseRoutineIds)
+}
+
+func (ac *AccessController) CanAccessExercises(userId string, exerciseIds []string) error {
+	query := ac.DB.Table("exercises").
+		Select("exercises.id, workout_sessions.user_id").
+		Joins("JOIN workout_sessions ON workout_sessions.id = exercises.workout_session_id AND workout_sessions.deleted_at IS NULL").
+		Where("exercises.deleted_at IS NULL")
+	return check(query, "exercises", userId, exerciseIds)
 }
 
-func (ac *AccessController) CanAccessExerciseRoutine(userId string, exerciseId string) error {
-	panic("unimplemented")
+func (ac *AccessController) CanAccessSetEntries(userId string, setEntryIds []string) error {
+	query := ac.DB.Table("set_entries").
+		Select("set_entries.id, workout_sessions.user_id").
+		Joins("JOIN exercises ON exercises.id = set_entries.exercise_id AND exercises.deleted_at IS NULL").
+		Joins("JOIN workout_sessions ON workout_sessions.id = exercises.workout_session_id AND workout_sessions.deleted_at IS NULL").
+		Where("set_entries.deleted_at IS NULL")
+	return check(query, "set_entries", userId, setEntryIds)
 }
 
-func (ac *AccessController) CanAccessSetEntry(userId string, exerciseId string) error {
-	panic("unimplemented")
+// check looks up the owner of every id in one query. Ids belonging to
+// someone else take precedence over ones that don't exist.
+func check(query *gorm.DB, table string, userId string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// ids that aren't numbers can't exist, leave them out so postgres doesn't fail the cast
+	lookup := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if n, err := strconv.ParseUint(id, 10, strconv.IntSize); err == nil {
+			lookup = append(lookup, uint(n))
+		}
+	}
+
+	owners := make(map[uint]string)
+	if len(lookup) > 0 {
+		var rows []owner
+		err := query.Where(table+".id IN ?", lookup).Scan(&rows).Error
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			owners[row.ID] = strconv.FormatUint(uint64(row.UserID), 10)
+		}
+	}
+
+	var missing, denied []string
+	for _, id := range ids {
+		n, err := strconv.ParseUint(id, 10, strconv.IntSize)
+		owner, ok := owners[uint(n)]
+		if err != nil || !ok {
+			missing = append(missing, id)
+		} else if owner != userId {
+			denied = append(denied, id)
+		}
+	}
+
+	if len(denied) > 0 {
+		return &accesscontroller.AccessDeniedError{IDs: denied}
+	}
+	if len(missing) > 0 {
+		return &accesscontroller.NotFoundError{IDs: missing}
+	}
+	return nil
 }
 
 func NewAccessControllerService(db *gorm.DB) accesscontroller.AccessControllerService {
@@ -1,11 +1,13 @@
 package accesscontrol
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/neilZon/workout-logger-api/accesscontroller"
 	"github.com/neilZon/workout-logger-api/helpers"
 	"github.com/neilZon/workout-logger-api/tests/testdata"
 	"github.com/stretchr/testify/require"
@@ -22,10 +24,10 @@ func TestAccessControl(t *testing.T) {
 		workoutRoutineId := fmt.Sprintf("%d", wr.ID)
 
 		workoutRoutineRow := sqlmock.
-			NewRows([]string{"id", "name", "user_id", "created_at", "deleted_at", "updated_at"}).
-			AddRow(wr.ID, wr.Name, wr.UserID, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt)
+			NewRows([]string{"id", "user_id"}).
+			AddRow(wr.ID, wr.UserID)
 
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(workoutRoutineId).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		ac := &AccessController{DB: gormDB}
 		err := ac.CanAccessWorkoutRoutine(userId, workoutRoutineId)
@@ -44,14 +46,36 @@ func TestAccessControl(t *testing.T) {
 		badUserId := 43
 		workoutRoutineId := fmt.Sprintf("%d", wr.ID)
 		workoutRoutineRow := sqlmock.
-			NewRows([]string{"id", "name", "user_id", "created_at", "deleted_at", "updated_at"}).
-			AddRow(wr.ID, wr.Name, badUserId, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt)
+			NewRows([]string{"id", "user_id"}).
+			AddRow(wr.ID, badUserId)
 
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(workoutRoutineId).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		ac := &AccessController{DB: gormDB}
 		err := ac.CanAccessWorkoutRoutine(userId, workoutRoutineId)
 		require.Equal(t, err.Error(), "Access Denied")
+		var denied *accesscontroller.AccessDeniedError
+		require.True(t, errors.As(err, &denied))
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Test Can Access Workout Routine Not Found", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		userId := fmt.Sprintf("%d", wr.UserID)
+		workoutRoutineId := fmt.Sprintf("%d", wr.ID)
+
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}))
+
+		ac := &AccessController{DB: gormDB}
+		err := ac.CanAccessWorkoutRoutine(userId, workoutRoutineId)
+		var notFound *accesscontroller.NotFoundError
+		require.True(t, errors.As(err, &notFound))
+		require.Equal(t, []string{workoutRoutineId}, notFound.IDs)
 
 		err = mock.ExpectationsWereMet()
 		if err != nil {
@@ -66,10 +90,10 @@ func TestAccessControl(t *testing.T) {
 		workoutSessionId := fmt.Sprintf("%d", ws.ID)
 
 		workoutSessionRow := sqlmock.
-			NewRows([]string{"id", "user_id", "start", "end", "workout_routine_id", "created_at", "deleted_at", "updated_at"}).
-			AddRow(ws.ID, ws.UserID, ws.Start, ws.End, ws.WorkoutRoutineID, ws.CreatedAt, ws.DeletedAt, ws.UpdatedAt)
+			NewRows([]string{"id", "user_id"}).
+			AddRow(ws.ID, ws.UserID)
 
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutSessionAccessQuery)).WithArgs(workoutSessionId).WillReturnRows(workoutSessionRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutSessionAccessQuery)).WithArgs(ws.ID).WillReturnRows(workoutSessionRow)
 
 		ac := &AccessController{DB: gormDB}
 		err := ac.CanAccessWorkoutSession(userId, workoutSessionId)
@@ -89,10 +113,10 @@ func TestAccessControl(t *testing.T) {
 		workoutSessionId := fmt.Sprintf("%d", ws.ID)
 
 		workoutSessionRow := sqlmock.
-			NewRows([]string{"id", "user_id", "start", "end", "workout_routine_id", "created_at", "deleted_at", "updated_at"}).
-			AddRow(ws.ID, badUserId, ws.Start, ws.End, ws.WorkoutRoutineID, ws.CreatedAt, ws.DeletedAt, ws.UpdatedAt)
+			NewRows([]string{"id", "user_id"}).
+			AddRow(ws.ID, badUserId)
 
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutSessionAccessQuery)).WithArgs(workoutSessionId).WillReturnRows(workoutSessionRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutSessionAccessQuery)).WithArgs(ws.ID).WillReturnRows(workoutSessionRow)
 
 		ac := &AccessController{DB: gormDB}
 		err := ac.CanAccessWorkoutSession(userId, workoutSessionId)
@@ -103,4 +127,107 @@ func TestAccessControl(t *testing.T) {
 			panic(err)
 		}
 	})
+
+	t.Run("Test Can Access Exercise Routine joins its workout routine", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		row := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(3, 28)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.ExerciseRoutineAccessQuery)).WithArgs(3).WillReturnRows(row)
+
+		ac := &AccessController{DB: gormDB}
+		err := ac.CanAccessExerciseRoutine("28", "3")
+		require.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Test Can Access Exercise joins its workout session", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		row := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(7, 99)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.ExerciseAccessQuery)).WithArgs(7).WillReturnRows(row)
+
+		ac := &AccessController{DB: gormDB}
+		err := ac.CanAccessExercise("28", "7")
+		require.Equal(t, err.Error(), "Access Denied")
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Test Can Access Set Entry joins its exercise and workout session", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		row := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(12, 28)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.SetEntryAccessQuery)).WithArgs(12).WillReturnRows(row)
+
+		ac := &AccessController{DB: gormDB}
+		err := ac.CanAccessSetEntry("28", "12")
+		require.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Test Can Access Exercise Routines checks every id in one query", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		rows := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(3, 28).AddRow(4, 28)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT exercise_routines.id, workout_routines.user_id FROM "exercise_routines" JOIN workout_routines ON workout_routines.id = exercise_routines.workout_routine_id AND workout_routines.deleted_at IS NULL WHERE exercise_routines.deleted_at IS NULL AND exercise_routines.id IN ($1,$2,$3)`)).
+			WithArgs(3, 4, 5).
+			WillReturnRows(rows)
+
+		ac := &AccessController{DB: gormDB}
+		err := ac.CanAccessExerciseRoutines("28", []string{"3", "4", "5", "abc"})
+		var notFound *accesscontroller.NotFoundError
+		require.True(t, errors.As(err, &notFound))
+		require.Equal(t, []string{"5", "abc"}, notFound.IDs)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Test Can Access Workout Sessions denied wins over not found", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		rows := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(3, 28).AddRow(4, 1)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT workout_sessions.id, workout_sessions.user_id FROM "workout_sessions" WHERE workout_sessions.deleted_at IS NULL AND workout_sessions.id IN ($1,$2,$3)`)).
+			WithArgs(3, 4, 5).
+			WillReturnRows(rows)
+
+		ac := &AccessController{DB: gormDB}
+		err := ac.CanAccessWorkoutSessions("28", []string{"3", "4", "5"})
+		var denied *accesscontroller.AccessDeniedError
+		require.True(t, errors.As(err, &denied))
+		require.Equal(t, []string{"4"}, denied.IDs)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Test Can Access nothing to check", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		ac := &AccessController{DB: gormDB}
+		require.Nil(t, ac.CanAccessSetEntries("28", []string{}))
+		err := ac.CanAccessExercise("28", "not-a-number")
+		var notFound *accesscontroller.NotFoundError
+		require.True(t, errors.As(err, &notFound))
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
 }
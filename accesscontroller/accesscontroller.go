@@ -4,7 +4,32 @@ package accesscontroller
 type AccessControllerService interface {
 	CanAccessWorkoutRoutine(userId string, workoutRoutineId string) error
 	CanAccessWorkoutSession(userId string, workoutSessionId string) error
-	CanAccessExerciseRoutine(userId string, exerciseId string) error
+	CanAccessExerciseRoutine(userId string, exerciseRoutineId string) error
 	CanAccessExercise(userId string, exerciseId string) error
-	CanAccessSetEntry(userId string, exerciseId string) error
+	CanAccessSetEntry(userId string, setEntryId string) error
+
+	// batched checks for mutations that take lists, they fail if any of the ids can't be accessed
+	CanAccessWorkoutRoutines(userId string, workoutRoutineIds []string) error
+	CanAccessWorkoutSessions(userId string, workoutSessionIds []string) error
+	CanAccessExerciseRoutines(userId string, exerciseRoutineIds []string) error
+	CanAccessExercises(userId string, exerciseIds []string) error
+	CanAccessSetEntries(userId string, setEntryIds []string) error
+}
+
+// NotFoundError is returned when some of the ids checked don't exist
+type NotFoundError struct {
+	IDs []string
+}
+
+func (n *NotFoundError) Error() string {
+	return "Not Found"
+}
+
+// AccessDeniedError is returned when some of the ids checked belong to another user
+type AccessDeniedError struct {
+	IDs []string
+}
+
+func (a *AccessDeniedError) Error() string {
+	return "Access Denied"
 }
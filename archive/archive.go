@@ -0,0 +1,341 @@
+// Package archive converts everything a user owns to and from a portable,
+// versioned JSON document so it can be moved between accounts or environments.
+package archive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/validator"
+	"gorm.io/gorm"
+)
+
+// Version is bumped whenever the document changes in a way older readers can't handle
+const Version = 1
+
+var ErrUnsupportedVersion = errors.New("unsupported archive version")
+
+// InvalidRecordError is a record the resolvers wouldn't have accepted, e.g. a
+// name too long for its column
+type InvalidRecordError struct {
+	// which record, e.g. "workout routine 4"
+	Record string
+	Err    error
+}
+
+func (e *InvalidRecordError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Record, e.Err)
+}
+
+func (e *InvalidRecordError) Unwrap() error {
+	return e.Err
+}
+
+// Archive is the exported document. IDs are only references between records
+// inside the document, imports assign new ones.
+type Archive struct {
+	Version         int              `json:"version"`
+	ExportedAt      time.Time        `json:"exportedAt"`
+	Profile         Profile          `json:"profile"`
+	WorkoutRoutines []WorkoutRoutine `json:"workoutRoutines"`
+	WorkoutSessions []WorkoutSession `json:"workoutSessions"`
+}
+
+type Profile struct {
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type WorkoutRoutine struct {
+	ID               uint              `json:"id"`
+	Name             string            `json:"name"`
+	Active           bool              `json:"active"`
+	ExerciseRoutines []ExerciseRoutine `json:"exerciseRoutines"`
+}
+
+type ExerciseRoutine struct {
+	ID     uint   `json:"id"`
+	Name   string `json:"name"`
+	Sets   uint   `json:"sets"`
+	Reps   uint   `json:"reps"`
+	Active bool   `json:"active"`
+	// removed from the routine but kept for the sessions that used it
+	Removed bool `json:"removed,omitempty"`
+}
+
+type WorkoutSession struct {
+	WorkoutRoutineID uint       `json:"workoutRoutineId"`
+	Start            time.Time  `json:"start"`
+	End              *time.Time `json:"end,omitempty"`
+	Exercises        []Exercise `json:"exercises"`
+}
+
+type Exercise struct {
+	ExerciseRoutineID uint       `json:"exerciseRoutineId"`
+	Notes             string     `json:"notes"`
+	SetEntries        []SetEntry `json:"setEntries"`
+}
+
+type SetEntry struct {
+	Weight float32 `json:"weight"`
+	Reps   uint    `json:"reps"`
+}
+
+// Summary counts the records an import created
+type Summary struct {
+	WorkoutRoutines  int
+	ExerciseRoutines int
+	WorkoutSessions  int
+	Exercises        int
+	SetEntries       int
+}
+
+// Export reads a user's profile, routines and training history into an archive
+func Export(db *gorm.DB, userId uint) (*Archive, error) {
+	u, err := database.GetUserById(db, fmt.Sprintf("%d", userId))
+	if err != nil {
+		return nil, err
+	}
+
+	workoutRoutines, err := database.GetAllWorkoutRoutines(db, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	workoutSessions, err := database.GetAllWorkoutSessions(db, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Archive{
+		Version:    Version,
+		ExportedAt: time.Now().UTC(),
+		Profile: Profile{
+			Name:      u.Name,
+			Email:     u.Email,
+			CreatedAt: u.CreatedAt,
+		},
+		WorkoutRoutines: []WorkoutRoutine{},
+		WorkoutSessions: []WorkoutSession{},
+	}
+
+	for _, wr := range workoutRoutines {
+		routine := WorkoutRoutine{
+			ID:               wr.ID,
+			Name:             wr.Name,
+			Active:           wr.Active,
+			ExerciseRoutines: []ExerciseRoutine{},
+		}
+		for _, er := range wr.ExerciseRoutines {
+			routine.ExerciseRoutines = append(routine.ExerciseRoutines, ExerciseRoutine{
+				ID:      er.ID,
+				Name:    er.Name,
+				Sets:    er.Sets,
+				Reps:    er.Reps,
+				Active:  er.Active,
+				Removed: er.DeletedAt.Valid,
+			})
+		}
+		a.WorkoutRoutines = append(a.WorkoutRoutines, routine)
+	}
+
+	for _, ws := range workoutSessions {
+		session := WorkoutSession{
+			WorkoutRoutineID: ws.WorkoutRoutineID,
+			Start:            ws.Start,
+			End:              ws.End,
+			Exercises:        []Exercise{},
+		}
+		for _, e := range ws.Exercises {
+			exercise := Exercise{
+				ExerciseRoutineID: e.ExerciseRoutineID,
+				Notes:             e.Notes,
+				SetEntries:        []SetEntry{},
+			}
+			for _, s := range e.Sets {
+				exercise.SetEntries = append(exercise.SetEntries, SetEntry{
+					Weight: s.Weight,
+					Reps:   s.Reps,
+				})
+			}
+			session.Exercises = append(session.Exercises, exercise)
+		}
+		a.WorkoutSessions = append(a.WorkoutSessions, session)
+	}
+
+	return a, nil
+}
+
+// Parse decodes an archive and checks that every reference in it points at a
+// record in the same document
+func Parse(data []byte) (*Archive, error) {
+	var a Archive
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, err
+	}
+	if a.Version != Version {
+		return nil, ErrUnsupportedVersion
+	}
+
+	// exercise routine ids of each workout routine
+	routines := make(map[uint]map[uint]bool)
+	for _, wr := range a.WorkoutRoutines {
+		if _, ok := routines[wr.ID]; ok {
+			return nil, fmt.Errorf("workout routine %d is in the archive more than once", wr.ID)
+		}
+		routines[wr.ID] = make(map[uint]bool)
+		for _, er := range wr.ExerciseRoutines {
+			if routines[wr.ID][er.ID] {
+				return nil, fmt.Errorf("exercise routine %d is in workout routine %d more than once", er.ID, wr.ID)
+			}
+			routines[wr.ID][er.ID] = true
+		}
+	}
+
+	for _, ws := range a.WorkoutSessions {
+		exerciseRoutines, ok := routines[ws.WorkoutRoutineID]
+		if !ok {
+			return nil, fmt.Errorf("workout session references unknown workout routine %d", ws.WorkoutRoutineID)
+		}
+		for _, e := range ws.Exercises {
+			if !exerciseRoutines[e.ExerciseRoutineID] {
+				return nil, fmt.Errorf("exercise references unknown exercise routine %d", e.ExerciseRoutineID)
+			}
+		}
+	}
+
+	if err := validate(&a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// validate checks records against the rules the resolvers creating them use
+// so they don't only fail once the import is rolled back by the database
+func validate(a *Archive) error {
+	for _, wr := range a.WorkoutRoutines {
+		if err := validator.WorkoutRoutineIsValid(&model.WorkoutRoutine{Name: wr.Name}); err != nil {
+			return &InvalidRecordError{Record: fmt.Sprintf("workout routine %d", wr.ID), Err: err}
+		}
+		for _, er := range wr.ExerciseRoutines {
+			if err := validator.ExerciseRoutineNameIsValid(er.Name); err != nil {
+				return &InvalidRecordError{Record: fmt.Sprintf("exercise routine %d", er.ID), Err: err}
+			}
+		}
+	}
+
+	for i, ws := range a.WorkoutSessions {
+		for _, e := range ws.Exercises {
+			if err := validator.ExerciseIsVaid(&model.Exercise{Notes: e.Notes}); err != nil {
+				return &InvalidRecordError{Record: fmt.Sprintf("workout session %d", i), Err: err}
+			}
+		}
+	}
+	return nil
+}
+
+// Import recreates an archive's routines and sessions for userId in a single
+// transaction, either everything is imported or nothing is
+func Import(db *gorm.DB, userId uint, a *Archive) (*Summary, error) {
+	summary := &Summary{}
+
+	// archive id to the id of the record created for it
+	workoutRoutineIds := make(map[uint]uint)
+	exerciseRoutineIds := make(map[uint]map[uint]uint)
+
+	tx := db.Begin()
+
+	for _, wr := range a.WorkoutRoutines {
+		routine := &database.WorkoutRoutine{
+			Name:   wr.Name,
+			Active: wr.Active,
+			UserID: userId,
+		}
+		for i, er := range wr.ExerciseRoutines {
+			exerciseRoutine := database.ExerciseRoutine{
+				Name:     er.Name,
+				Sets:     er.Sets,
+				Reps:     er.Reps,
+				Active:   er.Active,
+				Position: i,
+			}
+			if er.Removed {
+				exerciseRoutine.DeletedAt = gorm.DeletedAt{Time: a.ExportedAt, Valid: true}
+			}
+			routine.ExerciseRoutines = append(routine.ExerciseRoutines, exerciseRoutine)
+		}
+
+		if err := database.CreateWorkoutRoutine(tx, routine); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		// active defaults to true so false is left out of the insert
+		var inactive []uint
+		for i, er := range wr.ExerciseRoutines {
+			if !er.Active {
+				inactive = append(inactive, routine.ExerciseRoutines[i].ID)
+			}
+		}
+		if len(inactive) > 0 {
+			if err := tx.Unscoped().Model(&database.ExerciseRoutine{}).Where("id IN ?", inactive).Update("active", false).Error; err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		if !wr.Active {
+			if err := tx.Model(routine).Update("active", false).Error; err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+
+		workoutRoutineIds[wr.ID] = routine.ID
+		exerciseRoutineIds[wr.ID] = make(map[uint]uint)
+		for i, er := range wr.ExerciseRoutines {
+			exerciseRoutineIds[wr.ID][er.ID] = routine.ExerciseRoutines[i].ID
+		}
+		summary.WorkoutRoutines++
+		summary.ExerciseRoutines += len(routine.ExerciseRoutines)
+	}
+
+	for _, ws := range a.WorkoutSessions {
+		session := &database.WorkoutSession{
+			Start:            ws.Start,
+			End:              ws.End,
+			WorkoutRoutineID: workoutRoutineIds[ws.WorkoutRoutineID],
+			UserID:           userId,
+		}
+		for _, e := range ws.Exercises {
+			exercise := database.Exercise{
+				Notes:             e.Notes,
+				ExerciseRoutineID: exerciseRoutineIds[ws.WorkoutRoutineID][e.ExerciseRoutineID],
+			}
+			for _, s := range e.SetEntries {
+				exercise.Sets = append(exercise.Sets, database.SetEntry{
+					Weight: s.Weight,
+					Reps:   s.Reps,
+				})
+			}
+			session.Exercises = append(session.Exercises, exercise)
+			summary.SetEntries += len(exercise.Sets)
+		}
+
+		if err := database.AddWorkoutSession(tx, session); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		summary.WorkoutSessions++
+		summary.Exercises += len(session.Exercises)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
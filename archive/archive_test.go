@@ -0,0 +1,188 @@
+package archive
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// helpers.SetupMockDB can't be used here since helpers imports this package
+func setupMockDB() (sqlmock.Sqlmock, *gorm.DB) {
+	mockDb, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: mockDb,
+	}), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+
+	return mock, gormDB
+}
+
+const testArchive = `{
+	"version": 1,
+	"exportedAt": "2023-05-01T00:00:00Z",
+	"profile": {"name": "lifter", "email": "lifter@test.com"},
+	"workoutRoutines": [{
+		"id": 4,
+		"name": "Legs",
+		"active": true,
+		"exerciseRoutines": [{"id": 9, "name": "Squat", "sets": 5, "reps": 5, "active": true}]
+	}],
+	"workoutSessions": [{
+		"workoutRoutineId": 4,
+		"start": "2023-04-30T10:00:00Z",
+		"exercises": [{"exerciseRoutineId": 9, "notes": "felt heavy", "setEntries": [{"weight": 225, "reps": 5}]}]
+	}]
+}`
+
+func TestParse(t *testing.T) {
+	t.Run("Parses a valid archive", func(t *testing.T) {
+		a, err := Parse([]byte(testArchive))
+		require.Nil(t, err)
+		assert.Equal(t, "Legs", a.WorkoutRoutines[0].Name)
+		assert.Equal(t, uint(9), a.WorkoutSessions[0].Exercises[0].ExerciseRoutineID)
+	})
+
+	t.Run("Rejects other versions", func(t *testing.T) {
+		_, err := Parse([]byte(`{"version": 2}`))
+		assert.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("Rejects sessions of unknown routines", func(t *testing.T) {
+		_, err := Parse([]byte(`{"version": 1, "workoutSessions": [{"workoutRoutineId": 4}]}`))
+		assert.EqualError(t, err, "workout session references unknown workout routine 4")
+	})
+
+	t.Run("Rejects exercises of another routine's exercise routines", func(t *testing.T) {
+		_, err := Parse([]byte(`{
+			"version": 1,
+			"workoutRoutines": [{"id": 1, "exerciseRoutines": [{"id": 2}]}, {"id": 3}],
+			"workoutSessions": [{"workoutRoutineId": 3, "exercises": [{"exerciseRoutineId": 2}]}]
+		}`))
+		assert.EqualError(t, err, "exercise references unknown exercise routine 2")
+	})
+
+	t.Run("Rejects duplicate ids", func(t *testing.T) {
+		_, err := Parse([]byte(`{"version": 1, "workoutRoutines": [{"id": 1}, {"id": 1}]}`))
+		assert.EqualError(t, err, "workout routine 1 is in the archive more than once")
+	})
+
+	t.Run("Rejects names too long for their columns", func(t *testing.T) {
+		longName := strings.Repeat("a", 33)
+		var invalidRecordError *InvalidRecordError
+
+		_, err := Parse([]byte(fmt.Sprintf(`{"version": 1, "workoutRoutines": [{"id": 1, "name": "%s"}]}`, longName)))
+		require.ErrorAs(t, err, &invalidRecordError)
+		assert.EqualError(t, err, "workout routine 1: workout routine names must have less than 32 characters")
+
+		_, err = Parse([]byte(fmt.Sprintf(`{
+			"version": 1,
+			"workoutRoutines": [{"id": 1, "name": "Legs", "exerciseRoutines": [{"id": 2, "name": "%s"}]}]
+		}`, longName)))
+		require.ErrorAs(t, err, &invalidRecordError)
+		assert.EqualError(t, err, "exercise routine 2: exercise routine names must have less than 32 characters")
+	})
+
+	t.Run("Rejects notes too long for their column", func(t *testing.T) {
+		_, err := Parse([]byte(fmt.Sprintf(`{
+			"version": 1,
+			"workoutRoutines": [{"id": 1, "name": "Legs", "exerciseRoutines": [{"id": 2, "name": "Squat"}]}],
+			"workoutSessions": [{"workoutRoutineId": 1, "exercises": [{"exerciseRoutineId": 2, "notes": "%s"}]}]
+		}`, strings.Repeat("a", 513))))
+		var invalidRecordError *InvalidRecordError
+		require.ErrorAs(t, err, &invalidRecordError)
+		assert.EqualError(t, err, "workout session 0: max length of notes is 512 character")
+	})
+}
+
+func TestImport(t *testing.T) {
+	t.Run("Remaps ids in a single transaction", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+
+		a, err := Parse([]byte(testArchive))
+		require.Nil(t, err)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routines"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "Legs", true, 1, uint(12)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(40))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercise_routines"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "Squat", uint(5), uint(5), true, 0, uint(40)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(90))
+		// first version of the routine
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exercise_routines" WHERE workout_routine_id = $1 AND "exercise_routines"."deleted_at" IS NULL ORDER BY position, id`)).
+			WithArgs("40").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "sets", "reps", "position", "workout_routine_id"}).AddRow(90, "Squat", 5, 5, 0, 40))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routine_versions"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(40), 1, "Legs").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(60))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercise_routine_versions"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(60), uint(90), "Squat", uint(5), uint(5), 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(61))
+		mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "workout_routines" WHERE id = $1 AND "workout_routines"."deleted_at" IS NULL ORDER BY "workout_routines"."id" LIMIT 1 FOR SHARE`)).
+			WithArgs(uint(40)).
+			WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_sessions"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, sqlmock.AnyArg(), nil, uint(40), 1, uint(12)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(70))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercises"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "felt heavy", uint(90), uint(70)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(80))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "set_entries"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, float32(225), uint(5), uint(80)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+		mock.ExpectCommit()
+
+		summary, err := Import(gormDB, 12, a)
+		require.Nil(t, err)
+		assert.Equal(t, &Summary{WorkoutRoutines: 1, ExerciseRoutines: 1, WorkoutSessions: 1, Exercises: 1, SetEntries: 1}, summary)
+
+		require.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Rolls back when anything fails", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+
+		a, err := Parse([]byte(testArchive))
+		require.Nil(t, err)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routines"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(40))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercise_routines"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(90))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exercise_routines"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "sets", "reps", "position", "workout_routine_id"}).AddRow(90, "Squat", 5, 5, 0, 40))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routine_versions"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(60))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercise_routine_versions"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(61))
+		mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "workout_routines" WHERE id = $1 AND "workout_routines"."deleted_at" IS NULL ORDER BY "workout_routines"."id" LIMIT 1 FOR SHARE`)).
+			WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_sessions"`)).
+			WillReturnError(gorm.ErrInvalidData)
+		mock.ExpectExec(`ROLLBACK TO SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err = Import(gormDB, 12, a)
+		assert.NotNil(t, err)
+
+		require.Nil(t, mock.ExpectationsWereMet())
+	})
+}
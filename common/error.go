@@ -1,7 +1,27 @@
 package common
 
+import "time"
+
 type UnauthorizedError struct{}
 
 func (u *UnauthorizedError) Error() string {
 	return "Unauthorized"
 }
+
+// RateLimitedError is returned when a caller has made too many attempts
+// and needs to wait RetryAfter before trying again
+type RateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (r *RateLimitedError) Error() string {
+	return "Too many attempts, try again later"
+}
+
+// ForbiddenError is returned when the caller is signed in but isn't allowed
+// to do what they asked, like an api token missing a scope
+type ForbiddenError struct{}
+
+func (f *ForbiddenError) Error() string {
+	return "Forbidden"
+}
@@ -6,6 +6,44 @@ const (
 	ACCESS_TTL  time.Duration = 720 // hours
 	REFRESH_TTL time.Duration = 24  // hours
 
+	// failed password attempts before an account is temporarily locked
+	MAX_FAILED_LOGINS               = 5
+	LOGIN_LOCKOUT     time.Duration = 15 * time.Minute
+
+	// how long the six digit code sent with the verification link can be used
+	// for and how many guesses it allows
+	VERIFICATION_PIN_TTL          time.Duration = 30 * time.Minute
+	MAX_VERIFICATION_PIN_ATTEMPTS               = 5
+
+	// how long an emailed login link can be used for
+	LOGIN_LINK_TTL time.Duration = 15 * time.Minute
+	// how long the link confirming a new email can be used for
+	EMAIL_CHANGE_TTL time.Duration = 24 * time.Hour
+
+	// how long a deleted account can be restored before it is purged
+	DELETION_GRACE_PERIOD time.Duration = 30 * 24 * time.Hour
+	// how often accounts past their grace period are looked for
+	DELETION_PURGE_INTERVAL time.Duration = time.Hour
+
+	// queued email is retried with exponential backoff from OUTBOX_BASE_DELAY
+	// up to OUTBOX_MAX_DELAY and given up on after OUTBOX_MAX_ATTEMPTS
+	OUTBOX_MAX_ATTEMPTS                = 8
+	OUTBOX_BASE_DELAY    time.Duration = 30 * time.Second
+	OUTBOX_MAX_DELAY     time.Duration = time.Hour
+	OUTBOX_POLL_INTERVAL time.Duration = 5 * time.Second
+
+	// weekly digests cover monday to sunday in the user's timezone and are
+	// sent DIGEST_SEND_DELAY after the week ends
+	DIGEST_SEND_DELAY    time.Duration = 8 * time.Hour
+	DIGEST_POLL_INTERVAL time.Duration = 15 * time.Minute
+
+	// time allowed between entering a password and a second factor code
+	CHALLENGE_TTL time.Duration = 5 * time.Minute
+	// time allowed to sign in at a provider with a nonce from oidcNonce
+	OIDC_NONCE_TTL time.Duration = 10 * time.Minute
+	// name authenticator apps show next to the account
+	TOTP_ISSUER = "Until Failure"
+
 	// these are not the actual secrets, but are the keys to get the secrets
 	// from the .env file
 	ACCESS_SECRET  = "ACCESS_SECRET"
@@ -13,4 +51,46 @@ const (
 	EMAIL          = "EMAIL"
 	APP_PASSWORD   = "APP_PASSWORD"
 	HOST           = "HOST"
+
+	// directory of PEM keys used to sign tokens and the id of the active one,
+	// tokens are signed with ACCESS_SECRET and REFRESH_SECRET when not set
+	JWT_KEYS_DIR       = "JWT_KEYS_DIR"
+	JWT_SIGNING_KEY_ID = "JWT_SIGNING_KEY_ID"
+
+	// comma separated names of the openid connect providers users can sign in
+	// with, each configured by OIDC_<NAME>_ISSUER and OIDC_<NAME>_CLIENT_IDS
+	OIDC_PROVIDERS = "OIDC_PROVIDERS"
+
+	// password policy overrides, PASSWORD_REQUIRE is a comma separated list
+	// of number, upper, lower and symbol or "none". BREACHED_PASSWORDS_FILE adds a
+	// newline separated list of passwords to reject.
+	PASSWORD_MIN_LENGTH     = "PASSWORD_MIN_LENGTH"
+	PASSWORD_MAX_LENGTH     = "PASSWORD_MAX_LENGTH"
+	PASSWORD_REQUIRE        = "PASSWORD_REQUIRE"
+	BREACHED_PASSWORDS_FILE = "BREACHED_PASSWORDS_FILE"
+
+	// "memory" keeps rate limit counts in process, defaults to postgres
+	RATE_LIMIT_STORE = "RATE_LIMIT_STORE"
+
+	// overrides DELETION_GRACE_PERIOD, a duration such as "168h"
+	ACCOUNT_DELETION_GRACE = "ACCOUNT_DELETION_GRACE"
+
+	// "smtp" (default), "file" to write messages to a maildir at MAIL_DIR or
+	// "memory" to keep them in process
+	MAIL_TRANSPORT = "MAIL_TRANSPORT"
+	MAIL_DIR       = "MAIL_DIR"
+
+	// smtp server overrides, gmail is used by default. SMTP_TLS is starttls,
+	// tls or none and SMTP_AUTH is login, plain, cram-md5 or none. EMAIL is the
+	// username unless SMTP_USERNAME is set.
+	SMTP_HOST     = "SMTP_HOST"
+	SMTP_PORT     = "SMTP_PORT"
+	SMTP_TLS      = "SMTP_TLS"
+	SMTP_AUTH     = "SMTP_AUTH"
+	SMTP_USERNAME = "SMTP_USERNAME"
+
+	// proxies in front of the server that append the address they received a
+	// request from to X-Forwarded-For, defaults to 1 for the cloud run load
+	// balancer. 0 ignores the header and uses the connection's address.
+	TRUSTED_PROXY_HOPS = "TRUSTED_PROXY_HOPS"
 )
@@ -1,7 +1,9 @@
 package database
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -35,7 +37,33 @@ func GetUserByPasswordCode(db *gorm.DB, code string) (*User, error) {
 
 func VerifyUser(db *gorm.DB, id string, code string) error {
 	return db.Model(&User{}).Where("verification_code = ? AND id = ?", code, id).Updates(
-		map[string]interface{}{"Verified": true, "VerificationCode": nil, "VerificationSentAt": nil}).Error
+		map[string]interface{}{"Verified": true, "VerificationCode": nil, "VerificationSentAt": nil, "VerificationPin": nil, "VerificationPinAttempts": 0}).Error
+}
+
+// SetVerificationCodes replaces the link code and pin sent to verify a user's email
+func SetVerificationCodes(db *gorm.DB, email string, code string, pin string, sentAt time.Time) error {
+	return db.Model(&User{}).Where("email = ?", email).Updates(map[string]interface{}{
+		"verification_code":         code,
+		"verification_pin":          pin,
+		"verification_pin_attempts": 0,
+		"verification_sent_at":      sentAt,
+	}).Error
+}
+
+// RecordVerificationPinAttempt counts a guess at an unverified user's pin
+// before it is checked and returns the user
+func RecordVerificationPinAttempt(db *gorm.DB, email string) (*User, error) {
+	var users []User
+	result := db.Model(&users).Clauses(clause.Returning{}).
+		Where("email = ? AND NOT verified AND verification_pin IS NOT NULL", email).
+		Update("verification_pin_attempts", gorm.Expr("verification_pin_attempts + 1"))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(users) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &users[0], nil
 }
 
 func ChangePassword(db *gorm.DB, code string, password string) error {
@@ -55,60 +83,408 @@ func UpdateUserByVerificationCode(db *gorm.DB, code string, user *User) error {
 	return db.Model(&User{}).Where("verification_code = ?", code).Updates(*user).Error
 }
 
-func DeleteUser(db *gorm.DB, id string) error {
-	return db.Unscoped().Where("id = ?", id).Delete(&User{}).Error
+// UpdatePassword sets a new password hash and signs the user out everywhere
+// except keepSessionId so a leaked password stops working straight away
+func UpdatePassword(db *gorm.DB, id uint, password string, keepSessionId uint) error {
+	tx := db.Begin()
+
+	if err := tx.Model(&User{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"password":               password,
+		"password_reset_code":    nil,
+		"password_reset_sent_at": nil,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := revokeSessions(tx, id, keepSessionId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
 }
 
-func CreateWorkoutRoutine(db *gorm.DB, routine *WorkoutRoutine) *gorm.DB {
-	result := db.Create(routine)
-	return result
+// RehashPassword replaces a hash with an upgraded one, unless the password changed in the meantime
+func RehashPassword(db *gorm.DB, id uint, oldHash string, newHash string) error {
+	return db.Model(&User{}).Where("id = ? AND password = ?", id, oldHash).Update("password", newHash).Error
 }
 
-func GetWorkoutRoutine(db *gorm.DB, workoutRoutineId string) (*WorkoutRoutine, error) {
-	var wr WorkoutRoutine
-	result := db.First(&wr, "id = ?", workoutRoutineId)
-	return &wr, result.Error
+func StartEmailChange(db *gorm.DB, id uint, newEmail string, code string) error {
+	return db.Model(&User{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"pending_email":        newEmail,
+		"email_change_code":    code,
+		"email_change_sent_at": time.Now(),
+	}).Error
 }
 
-// Workout Routine
-func GetWorkoutRoutines(db *gorm.DB, userId string, cursor string, limit int) ([]WorkoutRoutine, error) {
-	var workoutRoutines []WorkoutRoutine
-	if len(cursor) == 0 {
-		db = db.Where("user_id = ?", userId)
-	} else {
-		db = db.Where("user_id = ? AND id > ?", userId, cursor)
+func UpdateUserLocale(db *gorm.DB, id uint, locale string) error {
+	return db.Model(&User{}).Where("id = ?", id).Update("locale", locale).Error
+}
+
+// ConfirmEmailChange swaps in the pending email for the change code sent
+// after sentAfter. Returns the user as it was before the change.
+func ConfirmEmailChange(db *gorm.DB, code string, sentAfter time.Time) (*User, error) {
+	var u User
+	tx := db.Begin()
+
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("email_change_code = ? AND email_change_sent_at > ? AND pending_email IS NOT NULL", code, sentAfter).
+		First(&u).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
-	result := db.Order("id").Limit(limit).Find(&workoutRoutines)
-	return workoutRoutines, result.Error
+
+	if err := tx.Model(&User{}).Where("id = ?", u.ID).Updates(map[string]interface{}{
+		"email":                *u.PendingEmail,
+		"pending_email":        nil,
+		"email_change_code":    nil,
+		"email_change_sent_at": nil,
+	}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return &u, tx.Commit().Error
+}
+
+// ConsumeLoginLink clears a login link code sent after sentAfter and returns
+// the user it belonged to so each link only works once. Opening the link
+// proves the user owns the email so they are verified too.
+func ConsumeLoginLink(db *gorm.DB, code string, sentAfter time.Time) (*User, error) {
+	var users []User
+	result := db.Model(&users).Clauses(clause.Returning{}).
+		Where("login_link_code = ? AND login_link_sent_at > ?", code, sentAfter).
+		Updates(map[string]interface{}{
+			"login_link_code":    nil,
+			"login_link_sent_at": nil,
+			"verified":           true,
+			"verification_code":  nil,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(users) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &users[0], nil
+}
+
+// ScheduleUserDeletion locks a user out and signs them out everywhere until
+// deleteAfter when they are purged, code lets them cancel before then. Run it
+// in a transaction along with sending code to the user.
+func ScheduleUserDeletion(tx *gorm.DB, id uint, code string, deleteAfter time.Time) error {
+	if err := tx.Model(&User{}).Where("id = ?", id).Updates(
+		map[string]interface{}{"DeleteAfter": deleteAfter, "DeletionCancelCode": code}).Error; err != nil {
+		return err
+	}
+
+	return revokeSessions(tx, id, 0)
+}
+
+// RestoreUser cancels the scheduled deletion code belongs to if its grace
+// period hasn't ended by now and returns the restored user
+func RestoreUser(db *gorm.DB, code string, now time.Time) (*User, error) {
+	var users []User
+	result := db.Model(&users).Clauses(clause.Returning{}).
+		Where("deletion_cancel_code = ? AND delete_after > ?", code, now).
+		Updates(map[string]interface{}{
+			"delete_after":         nil,
+			"deletion_cancel_code": nil,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(users) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &users[0], nil
+}
+
+// PurgeDeletedUsers permanently deletes users whose grace period ended before
+// now along with everything they own. Older databases were migrated before
+// the foreign keys cascaded so every table is cleared explicitly.
+func PurgeDeletedUsers(db *gorm.DB, now time.Time) (int64, error) {
+	var purged int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var users []User
+		err := tx.Unscoped().
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "email", "pending_email").
+			Where("delete_after <= ?", now).
+			Find(&users).Error
+		if err != nil || len(users) == 0 {
+			return err
+		}
+
+		var ids []uint
+		var emails []string
+		for _, u := range users {
+			ids = append(ids, u.ID)
+			emails = append(emails, u.Email)
+			if u.PendingEmail != nil {
+				emails = append(emails, *u.PendingEmail)
+			}
+		}
+
+		// other users' routines the purged users rated get recounted without them
+		var rated []uint
+		err = tx.Unscoped().Model(&RoutineRating{}).Where("user_id IN ?", ids).Pluck("published_routine_id", &rated).Error
+		if err != nil {
+			return err
+		}
+
+		owned := func(model interface{}) *gorm.DB {
+			return tx.Unscoped().Model(model).Select("id").Where("user_id IN ?", ids)
+		}
+		sessions := func() *gorm.DB { return owned(&WorkoutSession{}) }
+		routines := func() *gorm.DB { return owned(&WorkoutRoutine{}) }
+		published := func() *gorm.DB { return owned(&PublishedRoutine{}) }
+
+		// children are deleted before the rows they point at
+		purges := []struct {
+			model interface{}
+			query string
+			arg   interface{}
+		}{
+			{&SetEntry{}, "exercise_id IN (?)", tx.Unscoped().Model(&Exercise{}).Select("id").Where("workout_session_id IN (?)", sessions())},
+			{&Exercise{}, "workout_session_id IN (?)", sessions()},
+			{&WorkoutSession{}, "user_id IN ?", ids},
+			{&ExerciseRoutineVersion{}, "workout_routine_version_id IN (?)", tx.Unscoped().Model(&WorkoutRoutineVersion{}).Select("id").Where("workout_routine_id IN (?)", routines())},
+			{&WorkoutRoutineVersion{}, "workout_routine_id IN (?)", routines()},
+			{&RoutineShareCode{}, "user_id IN ?", ids},
+			{&ExerciseRoutine{}, "workout_routine_id IN (?)", routines()},
+			{&RoutineRating{}, "user_id IN ?", ids},
+			{&RoutineRating{}, "published_routine_id IN (?)", published()},
+			{&RoutineAdoption{}, "user_id IN ?", ids},
+			{&RoutineAdoption{}, "published_routine_id IN (?)", published()},
+			{&PublishedRoutineTag{}, "published_routine_id IN (?)", published()},
+			{&PublishedExerciseRoutine{}, "published_routine_id IN (?)", published()},
+			{&PublishedRoutine{}, "user_id IN ?", ids},
+			{&WorkoutRoutine{}, "user_id IN ?", ids},
+			{&RefreshToken{}, "user_id IN ?", ids},
+			{&Session{}, "user_id IN ?", ids},
+			{&ApiToken{}, "user_id IN ?", ids},
+			{&RecoveryCode{}, "user_id IN ?", ids},
+			{&Identity{}, "user_id IN ?", ids},
+			{&OutboxEmail{}, "recipient IN ?", emails},
+		}
+		for _, p := range purges {
+			err = tx.Unscoped().Where(p.query, p.arg).Delete(p.model).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(rated) != 0 {
+			err = tx.Model(&PublishedRoutine{}).Where("id IN ?", rated).Updates(ratingTotals()).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&User{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+	return purged, err
+}
+
+// RecordFailedLogin counts a failed password attempt and locks the account
+// for lockout once maxAttempts is reached
+func RecordFailedLogin(db *gorm.DB, id string, maxAttempts int, lockout time.Duration) error {
+	return db.Model(&User{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"failed_login_attempts": gorm.Expr("CASE WHEN failed_login_attempts + 1 >= ? THEN 0 ELSE failed_login_attempts + 1 END", maxAttempts),
+		"locked_until":          gorm.Expr("CASE WHEN failed_login_attempts + 1 >= ? THEN ? ELSE locked_until END", maxAttempts, time.Now().Add(lockout)),
+	}).Error
+}
+
+func ResetFailedLogins(db *gorm.DB, id string) error {
+	return db.Model(&User{}).Where("id = ?", id).Updates(
+		map[string]interface{}{"failed_login_attempts": 0, "locked_until": nil}).Error
+}
+
+// Admin
+// SearchUsers pages through users whose name or email contains search, an empty search matches everyone
+func SearchUsers(db *gorm.DB, search string, cursor string, limit int) ([]User, error) {
+	var users []User
+	if search != "" {
+		pattern := "%" + likeEscaper.Replace(search) + "%"
+		db = db.Where("email ILIKE ? OR name ILIKE ?", pattern, pattern)
+	}
+	if len(cursor) != 0 {
+		db = db.Where("id > ?", cursor)
+	}
+	result := db.Order("id").Limit(limit).Find(&users)
+	return users, result.Error
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// MarkUserVerified verifies a user without the code that was emailed to them
+func MarkUserVerified(db *gorm.DB, id uint) error {
+	return db.Model(&User{}).Where("id = ?", id).Updates(
+		map[string]interface{}{"Verified": true, "VerificationCode": nil, "VerificationSentAt": nil, "VerificationPin": nil, "VerificationPinAttempts": 0}).Error
+}
+
+// DisableUser stops a user from logging in and signs them out everywhere
+func DisableUser(db *gorm.DB, id uint) error {
+	tx := db.Begin()
+
+	if err := tx.Model(&User{}).Where("id = ? AND disabled_at IS NULL", id).Update("disabled_at", time.Now()).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := revokeSessions(tx, id, 0); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// EnableUser lets a disabled user log in again and clears any lockout
+func EnableUser(db *gorm.DB, id uint) error {
+	return db.Model(&User{}).Where("id = ?", id).Updates(
+		map[string]interface{}{"DisabledAt": nil, "FailedLoginAttempts": 0, "LockedUntil": nil}).Error
 }
 
-func UpdateWorkoutRoutine(db *gorm.DB, workoutRoutineId string, workoutRoutineName string, exerciseRoutines []*ExerciseRoutine) error {
+// ForcePasswordReset clears a user's password so it can't be used to log in,
+// signs them out everywhere and stores the reset code they need to set a new one
+func ForcePasswordReset(db *gorm.DB, id uint, code string) error {
 	tx := db.Begin()
 
-	if err := tx.Model(&WorkoutRoutine{}).Where("id = ?", workoutRoutineId).Update("name", workoutRoutineName).Error; err != nil {
+	if err := tx.Model(&User{}).Where("id = ?", id).Updates(
+		map[string]interface{}{"Password": "", "PasswordResetCode": code, "PasswordResetSentAt": time.Now()}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := revokeSessions(tx, id, 0); err != nil {
 		tx.Rollback()
 		return err
 	}
 
-	// exercise routines that are not present in this array are to be deleted
-	var exerciseRoutineIds []uint
+	return tx.Commit().Error
+}
 
-	// upsert exercise routines
-	for _, er := range exerciseRoutines {
-		result := tx.Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"reps", "sets", "name", "active"}),
-		}).Clauses(clause.Returning{}).Create(er)
+// Identity
+func GetUserByIdentity(db *gorm.DB, provider string, subject string) (*User, error) {
+	var u User
+	result := db.Select("users.*").
+		Joins("JOIN identities ON identities.user_id = users.id AND identities.deleted_at IS NULL").
+		Where("identities.provider = ? AND identities.subject = ?", provider, subject).
+		First(&u)
+	return &u, result.Error
+}
+
+// LinkIdentity adds an identity to an existing user, verifying them if the
+// provider has vouched for their email
+func LinkIdentity(db *gorm.DB, identity *Identity, verified bool) error {
+	tx := db.Begin()
 
-		exerciseRoutineIds = append(exerciseRoutineIds, er.ID)
+	if err := tx.Create(identity).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
 
-		if err := result.Error; err != nil {
+	if verified {
+		if err := tx.Model(&User{}).Where("id = ?", identity.UserID).Updates(
+			map[string]interface{}{"verified": true, "verification_code": nil}).Error; err != nil {
 			tx.Rollback()
 			return err
 		}
 	}
 
-	if err := tx.Where("workout_routine_id = ? AND id NOT IN ?", workoutRoutineId, exerciseRoutineIds).Delete(&ExerciseRoutine{}).Error; err != nil {
+	return tx.Commit().Error
+}
+
+// CreateUserWithIdentity creates a user who signed up through a provider. Run
+// it in a transaction so neither is created without the other.
+func CreateUserWithIdentity(db *gorm.DB, user *User, identity *Identity) error {
+	if err := db.Create(user).Error; err != nil {
+		return err
+	}
+
+	identity.UserID = user.ID
+	return db.Create(identity).Error
+}
+
+// OIDC Nonce
+func CreateOidcNonce(db *gorm.DB, nonce string, expiresAt time.Time) error {
+	return db.Create(&OidcNonce{Nonce: nonce, ExpiresAt: expiresAt}).Error
+}
+
+// UseOidcNonce deletes the nonce, reporting whether it was issued and hadn't expired
+func UseOidcNonce(db *gorm.DB, nonce string, now time.Time) (bool, error) {
+	result := db.Where("nonce = ? AND expires_at > ?", nonce, now).Delete(&OidcNonce{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Rate Limit
+// HitRateLimit increments the count for key, starting a new window when the
+// previous one has ended
+func HitRateLimit(db *gorm.DB, key string, now time.Time, window time.Duration) (*RateLimit, error) {
+	var rl RateLimit
+	err := db.Raw(`
+		INSERT INTO rate_limits (key, count, reset_at) VALUES (?, 1, ?)
+		ON CONFLICT (key) DO UPDATE SET
+			count = CASE WHEN rate_limits.reset_at <= ? THEN 1 ELSE rate_limits.count + 1 END,
+			reset_at = CASE WHEN rate_limits.reset_at <= ? THEN EXCLUDED.reset_at ELSE rate_limits.reset_at END
+		RETURNING key, count, reset_at`,
+		key, now.Add(window), now, now,
+	).Scan(&rl).Error
+	return &rl, err
+}
+
+// Session
+func CreateSession(db *gorm.DB, session *Session) error {
+	return db.Create(session).Error
+}
+
+func GetSession(db *gorm.DB, sessionId string) (*Session, error) {
+	var s Session
+	result := db.First(&s, "id = ?", sessionId)
+	return &s, result.Error
+}
+
+func GetActiveSessions(db *gorm.DB, userId string) ([]Session, error) {
+	var sessions []Session
+	result := db.Where("user_id = ? AND revoked_at IS NULL", userId).Order("last_used_at desc").Find(&sessions)
+	return sessions, result.Error
+}
+
+func TouchSession(db *gorm.DB, sessionId uint) error {
+	return db.Model(&Session{}).Where("id = ?", sessionId).Update("last_used_at", time.Now()).Error
+}
+
+// RevokeSession revokes a session along with every refresh token issued to it
+func RevokeSession(db *gorm.DB, sessionId uint) error {
+	now := time.Now()
+	tx := db.Begin()
+
+	if err := tx.Model(&Session{}).Where("id = ? AND revoked_at IS NULL", sessionId).Update("revoked_at", now).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(&RefreshToken{}).Where("session_id = ? AND revoked_at IS NULL", sessionId).Update("revoked_at", now).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func RevokeUserSessions(db *gorm.DB, userId string) error {
+	tx := db.Begin()
+
+	if err := revokeSessions(tx, userId, 0); err != nil {
 		tx.Rollback()
 		return err
 	}
@@ -116,6 +492,455 @@ func UpdateWorkoutRoutine(db *gorm.DB, workoutRoutineId string, workoutRoutineNa
 	return tx.Commit().Error
 }
 
+// revokeSessions revokes every session a user has other than keepSessionId, 0 keeps none
+func revokeSessions(tx *gorm.DB, userId interface{}, keepSessionId uint) error {
+	now := time.Now()
+
+	sessions := tx.Model(&Session{}).Where("user_id = ? AND revoked_at IS NULL", userId)
+	refreshTokens := tx.Model(&RefreshToken{}).Where("user_id = ? AND revoked_at IS NULL", userId)
+	if keepSessionId != 0 {
+		sessions = sessions.Where("id <> ?", keepSessionId)
+		refreshTokens = refreshTokens.Where("session_id <> ?", keepSessionId)
+	}
+
+	if err := sessions.Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+	return refreshTokens.Update("revoked_at", now).Error
+}
+
+// Refresh Token
+var ErrRefreshTokenReused = errors.New("refresh token has already been used")
+
+func CreateRefreshToken(db *gorm.DB, refreshToken *RefreshToken) error {
+	return db.Create(refreshToken).Error
+}
+
+func GetRefreshToken(db *gorm.DB, tokenId string) (*RefreshToken, error) {
+	var rt RefreshToken
+	result := db.First(&rt, "token_id = ?", tokenId)
+	return &rt, result.Error
+}
+
+// RotateRefreshToken marks the current token as used and stores its replacement.
+// If the current token was already rotated or revoked ErrRefreshTokenReused is returned
+func RotateRefreshToken(db *gorm.DB, current *RefreshToken, next *RefreshToken) error {
+	tx := db.Begin()
+
+	result := tx.Model(&RefreshToken{}).
+		Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", current.ID).
+		Update("rotated_at", time.Now())
+	if err := result.Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return ErrRefreshTokenReused
+	}
+
+	if err := tx.Create(next).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(&Session{}).Where("id = ?", current.SessionID).Update("last_used_at", time.Now()).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// Api Token
+func CreateApiToken(db *gorm.DB, apiToken *ApiToken) error {
+	return db.Create(apiToken).Error
+}
+
+// GetActiveApiToken finds an unrevoked, unexpired api token of an enabled user by its hash
+func GetActiveApiToken(db *gorm.DB, tokenHash string) (*ApiToken, error) {
+	var t ApiToken
+	result := db.Select("api_tokens.*").
+		Joins("JOIN users ON users.id = api_tokens.user_id AND users.disabled_at IS NULL AND users.delete_after IS NULL AND users.deleted_at IS NULL").
+		Where("api_tokens.token_hash = ? AND api_tokens.revoked_at IS NULL AND (api_tokens.expires_at IS NULL OR api_tokens.expires_at > ?)", tokenHash, time.Now()).
+		First(&t)
+	return &t, result.Error
+}
+
+func GetApiTokens(db *gorm.DB, userId string) ([]ApiToken, error) {
+	var tokens []ApiToken
+	result := db.Where("user_id = ? AND revoked_at IS NULL", userId).Order("created_at desc").Find(&tokens)
+	return tokens, result.Error
+}
+
+func TouchApiToken(db *gorm.DB, apiTokenId uint) error {
+	return db.Model(&ApiToken{}).Where("id = ?", apiTokenId).Update("last_used_at", time.Now()).Error
+}
+
+// RevokeApiToken revokes one of a user's api tokens, returns false if they have no such active token
+func RevokeApiToken(db *gorm.DB, apiTokenId string, userId uint) (bool, error) {
+	result := db.Model(&ApiToken{}).Where("id = ? AND user_id = ? AND revoked_at IS NULL", apiTokenId, userId).Update("revoked_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+// Outbox
+func QueueEmail(db *gorm.DB, email *OutboxEmail) error {
+	return db.Create(email).Error
+}
+
+// ClaimDueEmail locks the pending email that has waited longest for delivery,
+// rows locked by another worker are skipped. db must be a transaction.
+func ClaimDueEmail(db *gorm.DB, now time.Time) (*OutboxEmail, error) {
+	var email OutboxEmail
+	result := db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_attempt_at <= ?", OutboxPending, now).
+		Order("next_attempt_at").
+		First(&email)
+	return &email, result.Error
+}
+
+// MarkEmailSent records a delivered email, its body is cleared since it can
+// hold codes and links that shouldn't be kept around
+func MarkEmailSent(db *gorm.DB, id uint, sentAt time.Time) error {
+	return db.Model(&OutboxEmail{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     OutboxSent,
+		"attempts":   gorm.Expr("attempts + 1"),
+		"sent_at":    sentAt,
+		"last_error": "",
+		"body":       "",
+		"text_body":  "",
+	}).Error
+}
+
+// MarkEmailFailed records a failed attempt, status is pending to try again at
+// nextAttemptAt or dead to give up
+func MarkEmailFailed(db *gorm.DB, id uint, status string, nextAttemptAt time.Time, lastError string) error {
+	updates := map[string]interface{}{
+		"status":          status,
+		"attempts":        gorm.Expr("attempts + 1"),
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      lastError,
+	}
+	// dead emails won't be sent again so their body isn't kept either
+	if status == OutboxDead {
+		updates["body"] = ""
+		updates["text_body"] = ""
+	}
+	return db.Model(&OutboxEmail{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// GetLatestEmail returns the email of a kind most recently queued for recipient
+func GetLatestEmail(db *gorm.DB, recipient string, kind string) (*OutboxEmail, error) {
+	var email OutboxEmail
+	result := db.Where("recipient = ? AND kind = ?", recipient, kind).Order("id desc").First(&email)
+	return &email, result.Error
+}
+
+// Digest
+// UpdateDigestSettings opts a user in or out of weekly digests. Opting in
+// starts the schedule from now and keeps any unsubscribe code already sent.
+func UpdateDigestSettings(db *gorm.DB, id uint, enabled bool, timezone string, unsubscribeCode string, now time.Time) error {
+	updates := map[string]interface{}{
+		"digest_enabled": enabled,
+		"timezone":       timezone,
+	}
+	if enabled {
+		updates["digest_sent_at"] = gorm.Expr("CASE WHEN digest_enabled THEN digest_sent_at ELSE ? END", now)
+		updates["digest_unsubscribe_code"] = gorm.Expr("COALESCE(digest_unsubscribe_code, ?)", unsubscribeCode)
+	}
+	return db.Model(&User{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// UnsubscribeDigest opts the user the code was sent to out of weekly digests
+func UnsubscribeDigest(db *gorm.DB, code string) error {
+	result := db.Model(&User{}).Where("digest_unsubscribe_code = ?", code).Update("digest_enabled", false)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetDigestRecipients returns users who want digests and weren't sent one since sentBefore
+func GetDigestRecipients(db *gorm.DB, sentBefore time.Time) ([]User, error) {
+	var users []User
+	result := db.
+		Where("digest_enabled AND verified AND disabled_at IS NULL AND delete_after IS NULL AND digest_unsubscribe_code IS NOT NULL").
+		Where("digest_sent_at IS NULL OR digest_sent_at < ?", sentBefore).
+		Find(&users)
+	return users, result.Error
+}
+
+// ClaimDigest records a digest as sent, returns false if one was already sent since dueAt
+func ClaimDigest(db *gorm.DB, id uint, dueAt time.Time, now time.Time) (bool, error) {
+	result := db.Model(&User{}).
+		Where("id = ? AND digest_enabled AND (digest_sent_at IS NULL OR digest_sent_at < ?)", id, dueAt).
+		Update("digest_sent_at", now)
+	return result.RowsAffected == 1, result.Error
+}
+
+// TrainingTotals sums up the sessions a user started in a period
+type TrainingTotals struct {
+	Sessions int
+	// sum of weight times reps of every set
+	Volume float64
+}
+
+func GetTrainingTotals(db *gorm.DB, userId uint, start time.Time, end time.Time) (*TrainingTotals, error) {
+	var totals TrainingTotals
+	err := db.Raw(`
+		SELECT COUNT(DISTINCT workout_sessions.id) AS sessions, COALESCE(SUM(set_entries.weight * set_entries.reps), 0) AS volume
+		FROM workout_sessions
+			LEFT JOIN exercises ON exercises.workout_session_id = workout_sessions.id AND exercises.deleted_at IS NULL
+			LEFT JOIN set_entries ON set_entries.exercise_id = exercises.id AND set_entries.deleted_at IS NULL
+		WHERE workout_sessions.user_id = ? AND workout_sessions.start >= ? AND workout_sessions.start < ? AND workout_sessions.deleted_at IS NULL`,
+		userId, start, end,
+	).Scan(&totals).Error
+	return &totals, err
+}
+
+// PersonalBest is the heaviest set of an exercise in a period that beat
+// everything logged for it before
+type PersonalBest struct {
+	ExerciseRoutineID uint
+	Name              string
+	Weight            float32
+	PreviousWeight    float32
+}
+
+func GetPersonalBests(db *gorm.DB, userId uint, start time.Time, end time.Time) ([]PersonalBest, error) {
+	bests := []PersonalBest{}
+	err := db.Raw(`
+		SELECT bests.exercise_routine_id, exercise_routines.name, bests.weight, bests.previous_weight FROM (
+			SELECT exercises.exercise_routine_id,
+				MAX(set_entries.weight) FILTER (WHERE workout_sessions.start >= ?) AS weight,
+				MAX(set_entries.weight) FILTER (WHERE workout_sessions.start < ?) AS previous_weight
+			FROM workout_sessions
+				JOIN exercises ON exercises.workout_session_id = workout_sessions.id
+				JOIN set_entries ON set_entries.exercise_id = exercises.id
+			WHERE workout_sessions.user_id = ? AND workout_sessions.start < ?
+				AND workout_sessions.deleted_at IS NULL AND exercises.deleted_at IS NULL AND set_entries.deleted_at IS NULL
+			GROUP BY exercises.exercise_routine_id
+		) bests JOIN exercise_routines ON exercise_routines.id = bests.exercise_routine_id
+		WHERE bests.weight > bests.previous_weight
+		ORDER BY exercise_routines.name`,
+		start, start, userId, end,
+	).Scan(&bests).Error
+	return bests, err
+}
+
+// GetNextWorkoutRoutine returns the active routine the user trained least
+// recently, routines they never trained come first
+func GetNextWorkoutRoutine(db *gorm.DB, userId uint) (*WorkoutRoutine, error) {
+	var wr WorkoutRoutine
+	result := db.
+		Select("workout_routines.*").
+		Joins("LEFT JOIN workout_sessions ON workout_sessions.workout_routine_id = workout_routines.id AND workout_sessions.deleted_at IS NULL").
+		Where("workout_routines.user_id = ? AND workout_routines.active", userId).
+		Group("workout_routines.id").
+		Order("MAX(workout_sessions.start) NULLS FIRST, workout_routines.id").
+		Take(&wr)
+	return &wr, result.Error
+}
+
+// Totp
+// StartTotpSetup stores a new secret that is not used for login until EnableTotp confirms it
+func StartTotpSetup(db *gorm.DB, id string, secret string) error {
+	return db.Model(&User{}).Where("id = ? AND totp_enabled = ?", id, false).Updates(
+		map[string]interface{}{"totp_secret": secret, "totp_last_step": 0}).Error
+}
+
+// EnableTotp turns on totp for login and replaces the user's recovery codes
+func EnableTotp(db *gorm.DB, id uint, step int64, recoveryCodeHashes []string) error {
+	tx := db.Begin()
+
+	if err := tx.Model(&User{}).Where("id = ?", id).Updates(
+		map[string]interface{}{"totp_enabled": true, "totp_last_step": step}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Unscoped().Where("user_id = ?", id).Delete(&RecoveryCode{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	codes := make([]RecoveryCode, len(recoveryCodeHashes))
+	for i, hash := range recoveryCodeHashes {
+		codes[i] = RecoveryCode{CodeHash: hash, UserID: id}
+	}
+	if err := tx.Create(&codes).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func DisableTotp(db *gorm.DB, id uint) error {
+	tx := db.Begin()
+
+	if err := tx.Model(&User{}).Where("id = ?", id).Updates(
+		map[string]interface{}{"totp_enabled": false, "totp_secret": nil, "totp_last_step": 0}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Unscoped().Where("user_id = ?", id).Delete(&RecoveryCode{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// UseTotpStep records step as used, returns false when it, or a later step, already was
+func UseTotpStep(db *gorm.DB, id uint, step int64) (bool, error) {
+	result := db.Model(&User{}).Where("id = ? AND totp_last_step < ?", id, step).Update("totp_last_step", step)
+	return result.RowsAffected == 1, result.Error
+}
+
+// StartTotpChallenge stores the id of the challenge token issued at login,
+// replacing any challenge the user hadn't finished
+func StartTotpChallenge(db *gorm.DB, id uint, challengeId string) error {
+	return db.Model(&User{}).Where("id = ?", id).Update("totp_challenge_id", challengeId).Error
+}
+
+// UseTotpChallenge clears the user's challenge so its token can't be exchanged
+// again, returns false if challengeId isn't their current challenge
+func UseTotpChallenge(db *gorm.DB, id uint, challengeId string) (bool, error) {
+	result := db.Model(&User{}).Where("id = ? AND totp_challenge_id = ?", id, challengeId).Update("totp_challenge_id", nil)
+	return result.RowsAffected == 1, result.Error
+}
+
+// UseRecoveryCode marks a recovery code used, returns false if it doesn't exist or was already used
+func UseRecoveryCode(db *gorm.DB, userId uint, codeHash string) (bool, error) {
+	result := db.Model(&RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userId, codeHash).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+// CreateWorkoutRoutine creates a workout routine and its first version
+func CreateWorkoutRoutine(db *gorm.DB, routine *WorkoutRoutine) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		routine.Version = 1
+		if err := tx.Create(routine).Error; err != nil {
+			return err
+		}
+		return snapshotWorkoutRoutine(tx, routine)
+	})
+}
+
+func GetWorkoutRoutine(db *gorm.DB, workoutRoutineId string) (*WorkoutRoutine, error) {
+	var wr WorkoutRoutine
+	result := db.First(&wr, "id = ?", workoutRoutineId)
+	return &wr, result.Error
+}
+
+// Workout Routine
+// GetWorkoutRoutines pages through a user's workout routines, a nil active
+// includes both active and archived ones
+func GetWorkoutRoutines(db *gorm.DB, userId string, active *bool, cursor string, limit int) ([]WorkoutRoutine, error) {
+	var workoutRoutines []WorkoutRoutine
+	if len(cursor) == 0 {
+		db = db.Where("user_id = ?", userId)
+	} else {
+		db = db.Where("user_id = ? AND id > ?", userId, cursor)
+	}
+	if active != nil {
+		db = db.Where("active = ?", *active)
+	}
+	result := db.Order("id").Limit(limit).Find(&workoutRoutines)
+	return workoutRoutines, result.Error
+}
+
+// GetAllWorkoutRoutines returns every workout routine of a user with all of
+// their exercise routines, including ones removed that sessions still point at
+func GetAllWorkoutRoutines(db *gorm.DB, userId uint) ([]WorkoutRoutine, error) {
+	var workoutRoutines []WorkoutRoutine
+	result := db.
+		Preload("ExerciseRoutines", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped().Order("position, id")
+		}).
+		Where("user_id = ?", userId).
+		Order("id").
+		Find(&workoutRoutines)
+	return workoutRoutines, result.Error
+}
+
+// UpdateWorkoutRoutine saves a new version of a workout routine, the
+// exercise routines given replace all of its current ones
+func UpdateWorkoutRoutine(db *gorm.DB, workoutRoutineId string, workoutRoutineName string, exerciseRoutines []*ExerciseRoutine) (*WorkoutRoutine, error) {
+	return changeWorkoutRoutine(db, workoutRoutineId, func(tx *gorm.DB, routine *WorkoutRoutine) error {
+		routine.Name = workoutRoutineName
+
+		// existing exercise routines have to be this routine's, the upsert would
+		// otherwise rewrite another routine's without bumping its version
+		var existingIds []uint
+		for _, er := range exerciseRoutines {
+			if er.ID != 0 {
+				existingIds = append(existingIds, er.ID)
+			}
+		}
+		if len(existingIds) > 0 {
+			var existing int64
+			err := tx.Model(&ExerciseRoutine{}).
+				Where("workout_routine_id = ? AND id IN ?", routine.ID, existingIds).
+				Count(&existing).Error
+			if err != nil {
+				return err
+			}
+			if int(existing) != len(existingIds) {
+				return ErrExerciseRoutinesMismatch
+			}
+		}
+
+		// exercise routines that are not present in this array are to be deleted
+		var exerciseRoutineIds []uint
+
+		// upsert exercise routines
+		for _, er := range exerciseRoutines {
+			result := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "id"}},
+				// active is left alone so editing a routine doesn't reactivate archived exercise routines
+				DoUpdates: clause.AssignmentColumns([]string{"reps", "sets", "name", "position"}),
+			}).Clauses(clause.Returning{}).Create(er)
+			if err := result.Error; err != nil {
+				return err
+			}
+
+			exerciseRoutineIds = append(exerciseRoutineIds, er.ID)
+		}
+
+		// soft deleted so sessions that used them can still be read
+		query := tx.Where("workout_routine_id = ?", routine.ID)
+		if len(exerciseRoutineIds) > 0 {
+			query = query.Where("id NOT IN ?", exerciseRoutineIds)
+		}
+		return query.Delete(&ExerciseRoutine{}).Error
+	})
+}
+
+// SetWorkoutRoutineActive archives or reactivates a workout routine. Archived
+// routines keep their exercise routines and sessions, they're only hidden
+// from lists asking for active routines.
+func SetWorkoutRoutineActive(db *gorm.DB, workoutRoutineId string, active bool) (*WorkoutRoutine, error) {
+	var workoutRoutines []WorkoutRoutine
+	result := db.Model(&workoutRoutines).Clauses(clause.Returning{}).
+		Where("id = ?", workoutRoutineId).
+		Update("active", active)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(workoutRoutines) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &workoutRoutines[0], nil
+}
+
 func DeleteWorkoutRoutine(db *gorm.DB, workoutRoutineId string) error {
 	tx := db.Begin()
 	if err := tx.Where("id = ?", workoutRoutineId).Delete(&WorkoutRoutine{}).Error; err != nil {
@@ -161,10 +986,401 @@ func DeleteWorkoutRoutine(db *gorm.DB, workoutRoutineId string) error {
 	return tx.Commit().Error
 }
 
+// Workout Routine Version
+
+// changeWorkoutRoutine runs change on a locked workout routine and saves what
+// it changed the routine to as the routine's next version
+func changeWorkoutRoutine(db *gorm.DB, workoutRoutineId interface{}, change func(tx *gorm.DB, routine *WorkoutRoutine) error) (*WorkoutRoutine, error) {
+	var routine WorkoutRoutine
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&routine, "id = ?", workoutRoutineId).Error
+		if err != nil {
+			return err
+		}
+
+		// routines created before versioning don't have a snapshot of how they were
+		var snapshots int64
+		err = tx.Model(&WorkoutRoutineVersion{}).
+			Where("workout_routine_id = ? AND version = ?", routine.ID, routine.Version).
+			Count(&snapshots).Error
+		if err != nil {
+			return err
+		}
+		if snapshots == 0 {
+			if err := snapshotWorkoutRoutine(tx, &routine); err != nil {
+				return err
+			}
+		}
+
+		if err := change(tx, &routine); err != nil {
+			return err
+		}
+
+		routine.Version++
+		err = tx.Model(&routine).Updates(map[string]interface{}{"name": routine.Name, "version": routine.Version}).Error
+		if err != nil {
+			return err
+		}
+		return snapshotWorkoutRoutine(tx, &routine)
+	})
+	return &routine, err
+}
+
+// snapshotWorkoutRoutine saves the routine's exercise routines as they are now
+// as its current version
+func snapshotWorkoutRoutine(tx *gorm.DB, routine *WorkoutRoutine) error {
+	exerciseRoutines, err := GetExerciseRoutines(tx, fmt.Sprintf("%d", routine.ID), nil)
+	if err != nil {
+		return err
+	}
+
+	version := &WorkoutRoutineVersion{
+		WorkoutRoutineID: routine.ID,
+		Version:          routine.Version,
+		Name:             routine.Name,
+	}
+	for _, er := range *exerciseRoutines {
+		version.ExerciseRoutines = append(version.ExerciseRoutines, ExerciseRoutineVersion{
+			ExerciseRoutineID: er.ID,
+			Name:              er.Name,
+			Sets:              er.Sets,
+			Reps:              er.Reps,
+			Position:          er.Position,
+		})
+	}
+	return tx.Create(version).Error
+}
+
+// GetWorkoutRoutineVersions returns every version of a workout routine, newest first
+func GetWorkoutRoutineVersions(db *gorm.DB, workoutRoutineId string) ([]WorkoutRoutineVersion, error) {
+	var versions []WorkoutRoutineVersion
+	result := db.
+		Preload("ExerciseRoutines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, id")
+		}).
+		Where("workout_routine_id = ?", workoutRoutineId).
+		Order("version DESC").
+		Find(&versions)
+	return versions, result.Error
+}
+
+func GetWorkoutRoutineVersion(db *gorm.DB, workoutRoutineId string, version int) (*WorkoutRoutineVersion, error) {
+	var v WorkoutRoutineVersion
+	result := db.
+		Preload("ExerciseRoutines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, id")
+		}).
+		Where("workout_routine_id = ? AND version = ?", workoutRoutineId, version).
+		First(&v)
+	return &v, result.Error
+}
+
+// RollbackWorkoutRoutine changes a workout routine back to how it was at
+// version, which is saved as a new version so later ones aren't lost
+func RollbackWorkoutRoutine(db *gorm.DB, workoutRoutineId string, version int) (*WorkoutRoutine, error) {
+	return changeWorkoutRoutine(db, workoutRoutineId, func(tx *gorm.DB, routine *WorkoutRoutine) error {
+		target, err := GetWorkoutRoutineVersion(tx, workoutRoutineId, version)
+		if err != nil {
+			return err
+		}
+		routine.Name = target.Name
+
+		exerciseRoutineIds := []uint{}
+		for _, er := range target.ExerciseRoutines {
+			// exercise routines removed since are brought back rather than
+			// recreated so sessions that used them still point at them
+			err := tx.Unscoped().Model(&ExerciseRoutine{}).
+				Where("id = ? AND workout_routine_id = ?", er.ExerciseRoutineID, routine.ID).
+				Updates(map[string]interface{}{
+					"name":       er.Name,
+					"sets":       er.Sets,
+					"reps":       er.Reps,
+					"position":   er.Position,
+					"deleted_at": nil,
+				}).Error
+			if err != nil {
+				return err
+			}
+			exerciseRoutineIds = append(exerciseRoutineIds, er.ExerciseRoutineID)
+		}
+
+		query := tx.Where("workout_routine_id = ?", routine.ID)
+		if len(exerciseRoutineIds) > 0 {
+			query = query.Where("id NOT IN ?", exerciseRoutineIds)
+		}
+		return query.Delete(&ExerciseRoutine{}).Error
+	})
+}
+
+// Routine Share Code
+func CreateRoutineShareCode(db *gorm.DB, shareCode *RoutineShareCode) error {
+	return db.Create(shareCode).Error
+}
+
+// GetSharedWorkoutRoutine returns the workout routine a share code is for with
+// its active exercise routines, unless the code was revoked or the routine deleted
+func GetSharedWorkoutRoutine(db *gorm.DB, code string) (*WorkoutRoutine, error) {
+	var wr WorkoutRoutine
+	result := db.
+		Preload("ExerciseRoutines", func(db *gorm.DB) *gorm.DB {
+			return db.Where("active = ?", true).Order("position, id")
+		}).
+		Joins("JOIN routine_share_codes ON routine_share_codes.workout_routine_id = workout_routines.id AND routine_share_codes.deleted_at IS NULL").
+		Where("routine_share_codes.code = ? AND routine_share_codes.revoked_at IS NULL", code).
+		First(&wr)
+	return &wr, result.Error
+}
+
+// RevokeRoutineShareCode stops one of a user's share codes working, returns
+// false if they have no such code or it was already revoked
+func RevokeRoutineShareCode(db *gorm.DB, userId uint, code string) (bool, error) {
+	result := db.Model(&RoutineShareCode{}).
+		Where("user_id = ? AND code = ? AND revoked_at IS NULL", userId, code).
+		Update("revoked_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+// CloneWorkoutRoutine copies a workout routine and its exercise routines into
+// a user's account as a new routine
+func CloneWorkoutRoutine(db *gorm.DB, routine *WorkoutRoutine, userId uint) (*WorkoutRoutine, error) {
+	clone := &WorkoutRoutine{
+		Name:   routine.Name,
+		UserID: userId,
+	}
+	for _, er := range routine.ExerciseRoutines {
+		clone.ExerciseRoutines = append(clone.ExerciseRoutines, ExerciseRoutine{
+			Name:     er.Name,
+			Sets:     er.Sets,
+			Reps:     er.Reps,
+			Position: er.Position,
+		})
+	}
+	return clone, CreateWorkoutRoutine(db, clone)
+}
+
+// Routine Library
+func preloadPublishedRoutine(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("ExerciseRoutines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, id")
+		}).
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("tag")
+		})
+}
+
+// PublishWorkoutRoutine copies a workout routine and its exercise routines
+// into the routine library, later changes to the routine aren't published
+func PublishWorkoutRoutine(db *gorm.DB, workoutRoutineId string, userId uint, tags []string, curated bool) (*PublishedRoutine, error) {
+	var routine WorkoutRoutine
+	err := db.
+		Preload("ExerciseRoutines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, id")
+		}).
+		First(&routine, "id = ?", workoutRoutineId).Error
+	if err != nil {
+		return nil, err
+	}
+
+	published := &PublishedRoutine{
+		Name:             routine.Name,
+		Curated:          curated,
+		WorkoutRoutineID: routine.ID,
+		UserID:           userId,
+	}
+	names := []string{routine.Name}
+	for _, er := range routine.ExerciseRoutines {
+		published.ExerciseRoutines = append(published.ExerciseRoutines, PublishedExerciseRoutine{
+			Name:     er.Name,
+			Sets:     er.Sets,
+			Reps:     er.Reps,
+			Position: er.Position,
+		})
+		names = append(names, er.Name)
+	}
+	published.SearchText = strings.Join(names, " ")
+	for _, tag := range tags {
+		published.Tags = append(published.Tags, PublishedRoutineTag{Tag: tag})
+	}
+
+	return published, db.Create(published).Error
+}
+
+// SearchRoutineLibrary pages through the routine library. search is matched
+// against routine and exercise names and routines need every one of tags,
+// an empty search and no tags matches everything
+func SearchRoutineLibrary(db *gorm.DB, search string, tags []string, curated *bool, cursor string, limit int) ([]PublishedRoutine, error) {
+	var routines []PublishedRoutine
+	if search != "" {
+		db = db.Where("to_tsvector('english', search_text) @@ plainto_tsquery('english', ?)", search)
+	}
+	if len(tags) != 0 {
+		db = db.Where("id IN (SELECT published_routine_id FROM published_routine_tags WHERE tag IN ? GROUP BY published_routine_id HAVING COUNT(*) = ?)", tags, len(tags))
+	}
+	if curated != nil {
+		db = db.Where("curated = ?", *curated)
+	}
+	if len(cursor) != 0 {
+		db = db.Where("id > ?", cursor)
+	}
+	result := preloadPublishedRoutine(db).Order("id").Limit(limit).Find(&routines)
+	return routines, result.Error
+}
+
+var ErrRatingOwnRoutine = errors.New("routines can't be rated by who published them")
+
+// RatePublishedRoutine records a user's rating of a library routine, replacing
+// any rating they gave it before, and returns the routine with its new totals
+func RatePublishedRoutine(db *gorm.DB, publishedRoutineId string, userId uint, rating int) (*PublishedRoutine, error) {
+	var published PublishedRoutine
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// ratings of the same routine are counted one at a time
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&published, "id = ?", publishedRoutineId).Error
+		if err != nil {
+			return err
+		}
+		if published.UserID == userId {
+			return ErrRatingOwnRoutine
+		}
+
+		err = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "published_routine_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rating", "updated_at"}),
+		}).Create(&RoutineRating{
+			PublishedRoutineID: published.ID,
+			UserID:             userId,
+			Rating:             rating,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&PublishedRoutine{}).Where("id = ?", published.ID).Updates(ratingTotals()).Error
+		if err != nil {
+			return err
+		}
+
+		return preloadPublishedRoutine(tx).First(&published, published.ID).Error
+	})
+	return &published, err
+}
+
+// ratingTotals recounts the ratings of the published routines being updated
+func ratingTotals() map[string]interface{} {
+	return map[string]interface{}{
+		"rating_count": gorm.Expr("(SELECT COUNT(*) FROM routine_ratings WHERE published_routine_id = published_routines.id AND deleted_at IS NULL)"),
+		"rating_total": gorm.Expr("(SELECT COALESCE(SUM(rating), 0) FROM routine_ratings WHERE published_routine_id = published_routines.id AND deleted_at IS NULL)"),
+	}
+}
+
+// AdoptPublishedRoutine clones a library routine into a user's workout
+// routines and counts the adoption the first time the user adopts it
+func AdoptPublishedRoutine(db *gorm.DB, publishedRoutineId string, userId uint) (*WorkoutRoutine, error) {
+	var adopted *WorkoutRoutine
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var published PublishedRoutine
+		err := preloadPublishedRoutine(tx).First(&published, "id = ?", publishedRoutineId).Error
+		if err != nil {
+			return err
+		}
+
+		adoption := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&RoutineAdoption{
+			PublishedRoutineID: published.ID,
+			UserID:             userId,
+		})
+		if adoption.Error != nil {
+			return adoption.Error
+		}
+		if adoption.RowsAffected != 0 {
+			err = tx.Model(&PublishedRoutine{}).Where("id = ?", published.ID).UpdateColumn("adoptions", gorm.Expr("adoptions + 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		routine := &WorkoutRoutine{Name: published.Name}
+		for _, er := range published.ExerciseRoutines {
+			routine.ExerciseRoutines = append(routine.ExerciseRoutines, ExerciseRoutine{
+				Name:     er.Name,
+				Sets:     er.Sets,
+				Reps:     er.Reps,
+				Position: er.Position,
+			})
+		}
+		adopted, err = CloneWorkoutRoutine(tx, routine, userId)
+		return err
+	})
+	return adopted, err
+}
+
+// UnpublishRoutine removes a routine from the library, returns false if there
+// is no such routine or userId didn't publish it, 0 lets anyone's be removed
+func UnpublishRoutine(db *gorm.DB, publishedRoutineId string, userId uint) (bool, error) {
+	if userId != 0 {
+		db = db.Where("user_id = ?", userId)
+	}
+	result := db.Delete(&PublishedRoutine{}, "id = ?", publishedRoutineId)
+	return result.RowsAffected == 1, result.Error
+}
+
 // Exercise Routine
+var ErrExerciseRoutinesMismatch = errors.New("exercise routines don't match the workout routine's")
+
+// AddExerciseRoutine adds an exercise routine after the workout routine's others
 func AddExerciseRoutine(db *gorm.DB, exerciseRoutine *ExerciseRoutine) error {
-	result := db.Create(exerciseRoutine)
-	return result.Error
+	_, err := changeWorkoutRoutine(db, exerciseRoutine.WorkoutRoutineID, func(tx *gorm.DB, routine *WorkoutRoutine) error {
+		err := tx.Model(&ExerciseRoutine{}).
+			Select("COALESCE(MAX(position) + 1, 0)").
+			Where("workout_routine_id = ?", exerciseRoutine.WorkoutRoutineID).
+			Scan(&exerciseRoutine.Position).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(exerciseRoutine).Error
+	})
+	return err
+}
+
+// ReorderExerciseRoutines positions a workout routine's exercise routines in
+// the order of exerciseRoutineIds, which must be all of them
+func ReorderExerciseRoutines(db *gorm.DB, workoutRoutineId string, exerciseRoutineIds []string) ([]ExerciseRoutine, error) {
+	var exerciseRoutines []ExerciseRoutine
+	_, err := changeWorkoutRoutine(db, workoutRoutineId, func(tx *gorm.DB, routine *WorkoutRoutine) error {
+		err := tx.Where("workout_routine_id = ?", workoutRoutineId).
+			Find(&exerciseRoutines).Error
+		if err != nil {
+			return err
+		}
+
+		byId := make(map[string]*ExerciseRoutine, len(exerciseRoutines))
+		for i := range exerciseRoutines {
+			byId[fmt.Sprintf("%d", exerciseRoutines[i].ID)] = &exerciseRoutines[i]
+		}
+		if len(exerciseRoutineIds) != len(byId) {
+			return ErrExerciseRoutinesMismatch
+		}
+
+		ordered := make([]ExerciseRoutine, 0, len(exerciseRoutineIds))
+		for position, id := range exerciseRoutineIds {
+			er, ok := byId[id]
+			if !ok {
+				return ErrExerciseRoutinesMismatch
+			}
+			// ids can't be listed twice
+			delete(byId, id)
+
+			if er.Position != position {
+				if err := tx.Model(er).Update("position", position).Error; err != nil {
+					return err
+				}
+				er.Position = position
+			}
+			ordered = append(ordered, *er)
+		}
+		exerciseRoutines = ordered
+		return nil
+	})
+	return exerciseRoutines, err
 }
 
 func UpdateExerciseRoutine(db *gorm.DB, exerciseRoutineId string, exerciseRoutine *ExerciseRoutine) error {
@@ -172,11 +1388,17 @@ func UpdateExerciseRoutine(db *gorm.DB, exerciseRoutineId string, exerciseRoutin
 	return result.Error
 }
 
-func GetExerciseRoutines(db *gorm.DB, workoutRoutineId string) (*[]ExerciseRoutine, error) {
+// GetExerciseRoutines returns a workout routine's exercise routines in order,
+// a nil active includes both active and archived ones
+func GetExerciseRoutines(db *gorm.DB, workoutRoutineId string, active *bool) (*[]ExerciseRoutine, error) {
 	exerciseRoutines := []ExerciseRoutine{}
 
+	db = db.Where("workout_routine_id = ?", workoutRoutineId)
+	if active != nil {
+		db = db.Where("active = ?", *active)
+	}
 	err := db.
-		Where("workout_routine_id = ?", workoutRoutineId).
+		Order("position, id").
 		Find(&exerciseRoutines).Error
 
 	return &exerciseRoutines, err
@@ -188,9 +1410,25 @@ func GetExerciseRoutineIdsByExercises(db *gorm.DB, exerciseIds []string) (*[]str
 	return &exerciseRoutineIds, err
 }
 
+// SetExerciseRoutineActive archives or reactivates an exercise routine, the
+// exercises logged against it stay as they are
+func SetExerciseRoutineActive(db *gorm.DB, exerciseRoutineId string, active bool) (*ExerciseRoutine, error) {
+	var exerciseRoutines []ExerciseRoutine
+	result := db.Model(&exerciseRoutines).Clauses(clause.Returning{}).
+		Where("id = ?", exerciseRoutineId).
+		Update("active", active)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(exerciseRoutines) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &exerciseRoutines[0], nil
+}
+
 func GetExerciseRoutinesByWorkoutRoutineId(db *gorm.DB, workoutRoutineIds []string) (*[]ExerciseRoutine, error) {
 	exerciseRoutine := []ExerciseRoutine{}
-	err := db.Where("workout_routine_id IN ?", workoutRoutineIds).Find(&exerciseRoutine).Error
+	err := db.Where("workout_routine_id IN ?", workoutRoutineIds).Order("position, id").Find(&exerciseRoutine).Error
 	return &exerciseRoutine, err
 }
 
@@ -206,35 +1444,36 @@ func GetExercisesById(db *gorm.DB, ids []string) (*[]Exercise, error) {
 }
 
 func DeleteExerciseRoutine(db *gorm.DB, exerciseRoutineId string) error {
-	tx := db.Begin()
-	if err := tx.Where("id = ?", exerciseRoutineId).Delete(&ExerciseRoutine{}).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-
-	// Cascade exercises
-	var exercises []*Exercise
-	if err := tx.Clauses(clause.Returning{}).Where("exercise_routine_id = ?", exerciseRoutineId).Delete(&exercises).Error; err != nil {
-		tx.Rollback()
+	var er ExerciseRoutine
+	if err := GetExerciseRoutine(db, exerciseRoutineId, &er); err != nil {
 		return err
 	}
-	var exerciseIds []string
-	for _, e := range exercises {
-		exerciseIds = append(exerciseIds, fmt.Sprintf("%d", e.ID))
-	}
 
-	// Cascade sets
-	if err := tx.Where("exercise_id IN ?", exerciseIds).Delete(&SetEntry{}).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-
-	return tx.Commit().Error
+	// soft deleted and its exercises kept so past sessions still read the same,
+	// rolling back to a version with it restores it
+	_, err := changeWorkoutRoutine(db, er.WorkoutRoutineID, func(tx *gorm.DB, routine *WorkoutRoutine) error {
+		return tx.Where("id = ?", exerciseRoutineId).Delete(&ExerciseRoutine{}).Error
+	})
+	return err
 }
 
+// AddWorkoutSession adds a session performed against the current version of
+// its workout routine, the routine is share locked so its version can't change
+// until the session is saved. gorm.ErrRecordNotFound is returned if there is
+// no such routine.
 func AddWorkoutSession(db *gorm.DB, workout *WorkoutSession) error {
-	result := db.Create(workout)
-	return result.Error
+	return db.Transaction(func(tx *gorm.DB) error {
+		var routine WorkoutRoutine
+		err := tx.Clauses(clause.Locking{Strength: "SHARE"}).
+			Select("version").
+			First(&routine, "id = ?", workout.WorkoutRoutineID).Error
+		if err != nil {
+			return err
+		}
+
+		workout.WorkoutRoutineVersion = routine.Version
+		return tx.Create(workout).Error
+	})
 }
 
 func GetWorkoutSession(db *gorm.DB, workoutSessionId string) (*WorkoutSession, error) {
@@ -260,6 +1499,22 @@ func GetWorkoutSessions(db *gorm.DB, userId string, cursor string, limit int) ([
 	return workoutSessions, result.Error
 }
 
+// GetAllWorkoutSessions returns every workout session of a user with their exercises and sets
+func GetAllWorkoutSessions(db *gorm.DB, userId uint) ([]WorkoutSession, error) {
+	var workoutSessions []WorkoutSession
+	result := db.
+		Preload("Exercises", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Preload("Exercises.Sets", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Where("user_id = ?", userId).
+		Order("start").
+		Find(&workoutSessions)
+	return workoutSessions, result.Error
+}
+
 func GetWorkoutSessionsById(db *gorm.DB, ids []string) (*[]WorkoutSession, error) {
 	workoutSessions := []WorkoutSession{}
 	err := db.Preload("WorkoutRoutine").Where("id IN ?", ids).Find(&workoutSessions).Error
@@ -0,0 +1,146 @@
+package database
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// helpers.SetupMockDB can't be used here since helpers depends on this package
+func setupMockDB() (sqlmock.Sqlmock, *gorm.DB) {
+	mockDb, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: mockDb,
+	}), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+
+	return mock, gormDB
+}
+
+func TestPurgeDeletedUsers(t *testing.T) {
+	t.Run("Deletes everything the user owns", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+		now := time.Now()
+
+		const (
+			userId    = 7
+			ratedId   = 30
+			sessions  = `SELECT "id" FROM "workout_sessions" WHERE user_id IN ($1)`
+			routines  = `SELECT "id" FROM "workout_routines" WHERE user_id IN ($1)`
+			published = `SELECT "id" FROM "published_routines" WHERE user_id IN ($1)`
+		)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","email","pending_email" FROM "users" WHERE delete_after <= $1 FOR UPDATE`)).
+			WithArgs(now).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email", "pending_email"}).AddRow(userId, "gone@test.com", "new@test.com"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "published_routine_id" FROM "routine_ratings" WHERE user_id IN ($1)`)).
+			WithArgs(userId).
+			WillReturnRows(sqlmock.NewRows([]string{"published_routine_id"}).AddRow(ratedId))
+
+		deletes := []string{
+			`DELETE FROM "set_entries" WHERE exercise_id IN (SELECT "id" FROM "exercises" WHERE workout_session_id IN (` + sessions + `))`,
+			`DELETE FROM "exercises" WHERE workout_session_id IN (` + sessions + `)`,
+			`DELETE FROM "workout_sessions" WHERE user_id IN ($1)`,
+			`DELETE FROM "exercise_routine_versions" WHERE workout_routine_version_id IN (SELECT "id" FROM "workout_routine_versions" WHERE workout_routine_id IN (` + routines + `))`,
+			`DELETE FROM "workout_routine_versions" WHERE workout_routine_id IN (` + routines + `)`,
+			`DELETE FROM "routine_share_codes" WHERE user_id IN ($1)`,
+			`DELETE FROM "exercise_routines" WHERE workout_routine_id IN (` + routines + `)`,
+			`DELETE FROM "routine_ratings" WHERE user_id IN ($1)`,
+			`DELETE FROM "routine_ratings" WHERE published_routine_id IN (` + published + `)`,
+			`DELETE FROM "routine_adoptions" WHERE user_id IN ($1)`,
+			`DELETE FROM "routine_adoptions" WHERE published_routine_id IN (` + published + `)`,
+			`DELETE FROM "published_routine_tags" WHERE published_routine_id IN (` + published + `)`,
+			`DELETE FROM "published_exercise_routines" WHERE published_routine_id IN (` + published + `)`,
+			`DELETE FROM "published_routines" WHERE user_id IN ($1)`,
+			`DELETE FROM "workout_routines" WHERE user_id IN ($1)`,
+			`DELETE FROM "refresh_tokens" WHERE user_id IN ($1)`,
+			`DELETE FROM "sessions" WHERE user_id IN ($1)`,
+			`DELETE FROM "api_tokens" WHERE user_id IN ($1)`,
+			`DELETE FROM "recovery_codes" WHERE user_id IN ($1)`,
+			`DELETE FROM "identities" WHERE user_id IN ($1)`,
+		}
+		for _, d := range deletes {
+			mock.ExpectExec(regexp.QuoteMeta(d)).WithArgs(userId).WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "outbox_emails" WHERE recipient IN ($1,$2)`)).
+			WithArgs("gone@test.com", "new@test.com").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "published_routines" SET "rating_count"=(SELECT COUNT(*) FROM routine_ratings WHERE published_routine_id = published_routines.id AND deleted_at IS NULL),"rating_total"=(SELECT COALESCE(SUM(rating), 0) FROM routine_ratings WHERE published_routine_id = published_routines.id AND deleted_at IS NULL),"updated_at"=$1 WHERE id IN ($2) AND "published_routines"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), ratedId).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "users" WHERE id IN ($1)`)).
+			WithArgs(userId).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		purged, err := PurgeDeletedUsers(gormDB, now)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), purged)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Does nothing when no grace period has ended", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+		now := time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","email","pending_email" FROM "users" WHERE delete_after <= $1 FOR UPDATE`)).
+			WithArgs(now).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email", "pending_email"}))
+		mock.ExpectCommit()
+
+		purged, err := PurgeDeletedUsers(gormDB, now)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), purged)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAddWorkoutSession(t *testing.T) {
+	const versionQuery = `SELECT "version" FROM "workout_routines" WHERE id = $1 AND "workout_routines"."deleted_at" IS NULL ORDER BY "workout_routines"."id" LIMIT 1 FOR SHARE`
+
+	t.Run("Records the routine's current version", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(versionQuery)).
+			WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_sessions" ("created_at","updated_at","deleted_at","start","end","workout_routine_id","workout_routine_version","user_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, sqlmock.AnyArg(), nil, 4, 3, 9).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+		mock.ExpectCommit()
+
+		session := &WorkoutSession{Start: time.Now(), WorkoutRoutineID: 4, UserID: 9}
+		err := AddWorkoutSession(gormDB, session)
+		require.NoError(t, err)
+		require.Equal(t, 3, session.WorkoutRoutineVersion)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fails when the routine doesn't exist", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(versionQuery)).
+			WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"version"}))
+		mock.ExpectRollback()
+
+		err := AddWorkoutSession(gormDB, &WorkoutSession{Start: time.Now(), WorkoutRoutineID: 4, UserID: 9})
+		require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
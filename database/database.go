@@ -24,6 +24,10 @@ func InitDb() (*gorm.DB, error) {
 	if err != nil {
 		return nil, err
 	}
-	db.AutoMigrate(User{}, WorkoutRoutine{}, ExerciseRoutine{}, WorkoutSession{}, Exercise{}, SetEntry{})
+	db.AutoMigrate(User{}, Session{}, RefreshToken{}, ApiToken{}, RateLimit{}, OidcNonce{}, OutboxEmail{}, RecoveryCode{}, Identity{}, WorkoutRoutine{}, WorkoutRoutineVersion{}, ExerciseRoutineVersion{}, RoutineShareCode{}, PublishedRoutine{}, PublishedExerciseRoutine{}, PublishedRoutineTag{}, RoutineRating{}, RoutineAdoption{}, ExerciseRoutine{}, WorkoutSession{}, Exercise{}, SetEntry{})
+	// gorm can't declare expression indexes so the one full text search uses is made here
+	db.Exec("CREATE INDEX IF NOT EXISTS idx_published_routines_search ON published_routines USING GIN (to_tsvector('english', search_text))")
+	// emails sent or given up on before their bodies were cleared
+	db.Exec("UPDATE outbox_emails SET body = '', text_body = '' WHERE status IN (?, ?) AND (body <> '' OR text_body <> '')", OutboxSent, OutboxDead)
 	return db, nil
 }
@@ -8,15 +8,147 @@ import (
 
 type User struct {
 	gorm.Model
-	Name                string           `gorm:"not null;type:varchar(50)"`
-	Email               string           `gorm:"unique;not null;type:varchar(80)"`
-	Password            string           `gorm:"not null;size:type:varchar(32)"`
-	WorkoutRoutines     []WorkoutRoutine `gorm:"constraint:OnDelete:CASCADE"`
-	Verified            bool             `gorm:"default:false"`
-	VerificationCode    *string          `gorm:"unique"`
-	VerificationSentAt  *time.Time
-	PasswordResetCode   *string `gorm:"unique"`
-	PasswordResetSentAt *time.Time
+	Name               string           `gorm:"not null;type:varchar(50)"`
+	Email              string           `gorm:"unique;not null;type:varchar(80)"`
+	Password           string           `gorm:"not null;size:255"`
+	WorkoutRoutines    []WorkoutRoutine `gorm:"constraint:OnDelete:CASCADE"`
+	Verified           bool             `gorm:"default:false"`
+	VerificationCode   *string          `gorm:"unique"`
+	VerificationSentAt *time.Time
+	// typed into the app instead of opening the emailed link
+	VerificationPin         *string `gorm:"size:6"`
+	VerificationPinAttempts int     `gorm:"not null;default:0"`
+	PasswordResetCode       *string `gorm:"unique"`
+	PasswordResetSentAt     *time.Time
+	LoginLinkCode           *string `gorm:"unique"`
+	LoginLinkSentAt         *time.Time
+	// new address waiting to be confirmed from its inbox
+	PendingEmail        *string `gorm:"type:varchar(80)"`
+	EmailChangeCode     *string `gorm:"unique"`
+	EmailChangeSentAt   *time.Time
+	FailedLoginAttempts int `gorm:"not null;default:0"`
+	LockedUntil         *time.Time
+	Sessions            []Session `gorm:"constraint:OnDelete:CASCADE"`
+	// secret is set when setup starts and only used for login once enabled
+	TotpSecret    *string        `gorm:"size:64"`
+	TotpEnabled   bool           `gorm:"default:false"`
+	TotpLastStep  int64          `gorm:"not null;default:0"`
+	RecoveryCodes []RecoveryCode `gorm:"constraint:OnDelete:CASCADE"`
+	Identities    []Identity     `gorm:"constraint:OnDelete:CASCADE"`
+	ApiTokens     []ApiToken     `gorm:"constraint:OnDelete:CASCADE"`
+	// id of the challenge token last issued at login, cleared once it is used
+	TotpChallengeID *string `gorm:"size:64"`
+	// empty for regular users
+	Role       string `gorm:"not null;default:'';size:16"`
+	DisabledAt *time.Time
+	// set while a deleted account can still be restored, the user is purged after DeleteAfter
+	DeleteAfter        *time.Time
+	DeletionCancelCode *string `gorm:"unique"`
+	// language emails are sent in, e.g. "es" or "en-gb"
+	Locale string `gorm:"not null;default:'en';size:16"`
+	// IANA name of the zone weekly digests are scheduled in
+	Timezone      string `gorm:"not null;default:'UTC';size:64"`
+	DigestEnabled bool   `gorm:"not null;default:false;index"`
+	// in the digest's unsubscribe link so it works without logging in
+	DigestUnsubscribeCode *string `gorm:"unique"`
+	DigestSentAt          *time.Time
+}
+
+// admins are promoted by setting their role in the database directly
+const RoleAdmin = "admin"
+
+// Identity links an account at an openid connect provider to a user
+type Identity struct {
+	gorm.Model
+	Provider string `gorm:"not null;size:32;uniqueIndex:idx_identity_provider_subject"`
+	Subject  string `gorm:"not null;size:255;uniqueIndex:idx_identity_provider_subject"`
+	UserID   uint   `gorm:"not null;index"`
+}
+
+// RecoveryCode is a hashed single use code that stands in for a totp code
+type RecoveryCode struct {
+	gorm.Model
+	CodeHash string `gorm:"not null;index;size:64"`
+	UsedAt   *time.Time
+	UserID   uint `gorm:"not null;index"`
+}
+
+// Session is a single login on a device. Every refresh token minted from
+// that login belongs to the session so the device can be revoked as a whole.
+type Session struct {
+	gorm.Model
+	DeviceName    string `gorm:"size:64"`
+	UserAgent     string `gorm:"size:512"`
+	IP            string `gorm:"size:64"`
+	LastUsedAt    time.Time
+	RevokedAt     *time.Time
+	RefreshTokens []RefreshToken `gorm:"constraint:OnDelete:CASCADE"`
+	UserID        uint           `gorm:"not null;index"`
+}
+
+// RefreshToken tracks an issued refresh token so it can be rotated and revoked
+type RefreshToken struct {
+	gorm.Model
+	TokenID   string    `gorm:"unique;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+	SessionID uint `gorm:"not null;index"`
+	UserID    uint `gorm:"not null;index"`
+}
+
+// ApiToken is a long lived personal access token, only a hash of the token is kept
+type ApiToken struct {
+	gorm.Model
+	Name      string `gorm:"not null;size:64"`
+	TokenHash string `gorm:"unique;not null;size:64"`
+	// first characters of the token so the user can tell them apart
+	Prefix     string `gorm:"not null;size:16"`
+	Scopes     string `gorm:"not null;size:255"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	UserID     uint `gorm:"not null;index"`
+}
+
+// OutboxEmail is an email waiting to be delivered, it is queued in the same
+// transaction as the change it is about and retried until it is sent or dead
+type OutboxEmail struct {
+	gorm.Model
+	// what the email is for, e.g. verification
+	Kind          string    `gorm:"not null;size:32;index:idx_outbox_recipient_kind"`
+	Recipient     string    `gorm:"not null;type:varchar(80);index:idx_outbox_recipient_kind"`
+	Subject       string    `gorm:"not null;size:255"`
+	Body          string    `gorm:"not null;type:text"`
+	TextBody      string    `gorm:"not null;default:'';type:text"`
+	Status        string    `gorm:"not null;size:16;index"`
+	Attempts      int       `gorm:"not null;default:0"`
+	NextAttemptAt time.Time `gorm:"not null;index"`
+	LastError     string    `gorm:"size:512"`
+	SentAt        *time.Time
+	// see mail.Message
+	ListUnsubscribe string `gorm:"not null;default:'';size:512"`
+}
+
+const (
+	OutboxPending = "pending"
+	OutboxSent    = "sent"
+	// gave up after too many failed attempts
+	OutboxDead = "dead"
+)
+
+// OidcNonce is handed out before a provider sign in and has to come back in
+// the ID token, it is deleted when used so a token can't be replayed
+type OidcNonce struct {
+	Nonce     string    `gorm:"primaryKey;size:64"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+}
+
+// RateLimit is the hit count for a rate limit key in its current window
+type RateLimit struct {
+	Key     string    `gorm:"primaryKey;size:256"`
+	Count   int       `gorm:"not null"`
+	ResetAt time.Time `gorm:"not null"`
 }
 
 type WorkoutRoutine struct {
@@ -25,7 +157,91 @@ type WorkoutRoutine struct {
 	ExerciseRoutines []ExerciseRoutine `gorm:"constraint:OnDelete:CASCADE"`
 	WorkoutSessions  []WorkoutSession  `gorm:"constraint:OnDelete:CASCADE"`
 	Active           bool              `gorm:"default:true"`
-	UserID           uint
+	// bumped every time the routine changes, see WorkoutRoutineVersion
+	Version  int                     `gorm:"not null;default:1"`
+	Versions []WorkoutRoutineVersion `gorm:"constraint:OnDelete:CASCADE"`
+	UserID   uint
+}
+
+// WorkoutRoutineVersion is a snapshot of a workout routine taken each time it
+// changes so sessions can be read against the routine they were performed with
+type WorkoutRoutineVersion struct {
+	gorm.Model
+	WorkoutRoutineID uint                     `gorm:"not null;uniqueIndex:idx_workout_routine_versions_version"`
+	Version          int                      `gorm:"not null;uniqueIndex:idx_workout_routine_versions_version"`
+	Name             string                   `gorm:"not null;size:32"`
+	ExerciseRoutines []ExerciseRoutineVersion `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// ExerciseRoutineVersion is an exercise routine as it was in a WorkoutRoutineVersion
+type ExerciseRoutineVersion struct {
+	gorm.Model
+	WorkoutRoutineVersionID uint   `gorm:"not null;index"`
+	ExerciseRoutineID       uint   `gorm:"not null"`
+	Name                    string `gorm:"not null;size:32"`
+	Sets                    uint   `gorm:"not null"`
+	Reps                    uint   `gorm:"not null"`
+	Position                int    `gorm:"not null"`
+}
+
+// RoutineShareCode lets anyone with the code preview a workout routine and
+// clone it into their own account until it is revoked
+type RoutineShareCode struct {
+	gorm.Model
+	Code             string `gorm:"not null;uniqueIndex;size:64"`
+	WorkoutRoutineID uint   `gorm:"not null;index"`
+	UserID           uint   `gorm:"not null"`
+	RevokedAt        *time.Time
+}
+
+// PublishedRoutine is a copy of a workout routine in the routine library that
+// anyone can adopt, curated ones were published by an admin
+type PublishedRoutine struct {
+	gorm.Model
+	Name    string `gorm:"not null;size:32"`
+	Curated bool   `gorm:"not null;default:false"`
+	// routine and exercise names matched by full text search
+	SearchText       string                     `gorm:"not null;type:text"`
+	Adoptions        int                        `gorm:"not null;default:0"`
+	RatingCount      int                        `gorm:"not null;default:0"`
+	RatingTotal      int                        `gorm:"not null;default:0"`
+	WorkoutRoutineID uint                       `gorm:"not null;index"`
+	UserID           uint                       `gorm:"not null;index"`
+	ExerciseRoutines []PublishedExerciseRoutine `gorm:"constraint:OnDelete:CASCADE"`
+	Tags             []PublishedRoutineTag      `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// PublishedExerciseRoutine is an exercise routine as it was when its workout
+// routine was published
+type PublishedExerciseRoutine struct {
+	gorm.Model
+	PublishedRoutineID uint   `gorm:"not null;index"`
+	Name               string `gorm:"not null;size:32"`
+	Sets               uint   `gorm:"not null"`
+	Reps               uint   `gorm:"not null"`
+	Position           int    `gorm:"not null"`
+}
+
+type PublishedRoutineTag struct {
+	PublishedRoutineID uint   `gorm:"primaryKey"`
+	Tag                string `gorm:"primaryKey;size:32;index"`
+}
+
+// RoutineRating is a user's 1 to 5 rating of a published routine, each user
+// rates a routine once
+type RoutineRating struct {
+	gorm.Model
+	PublishedRoutineID uint `gorm:"not null;uniqueIndex:idx_routine_ratings_user"`
+	UserID             uint `gorm:"not null;uniqueIndex:idx_routine_ratings_user"`
+	Rating             int  `gorm:"not null"`
+}
+
+// RoutineAdoption is a user having adopted a published routine, each user
+// counts once towards its adoptions however many times they adopt it
+type RoutineAdoption struct {
+	gorm.Model
+	PublishedRoutineID uint `gorm:"not null;uniqueIndex:idx_routine_adoptions_user"`
+	UserID             uint `gorm:"not null;uniqueIndex:idx_routine_adoptions_user"`
 }
 
 type ExerciseRoutine struct {
@@ -35,6 +251,7 @@ type ExerciseRoutine struct {
 	Reps             uint       `gorm:"not null"`
 	Exercises        []Exercise `gorm:"constraint:OnDelete:CASCADE"`
 	Active           bool       `gorm:"default:true"`
+	Position         int        `gorm:"not null;default:0"`
 	WorkoutRoutineID uint
 }
 
@@ -45,7 +262,9 @@ type WorkoutSession struct {
 	WorkoutRoutine   WorkoutRoutine
 	Exercises        []Exercise `gorm:"constraint:OnDelete:CASCADE"`
 	WorkoutRoutineID uint
-	UserID           uint
+	// version of the workout routine the session was performed against
+	WorkoutRoutineVersion int `gorm:"not null;default:1"`
+	UserID                uint
 }
 
 type Exercise struct {
@@ -0,0 +1,181 @@
+// Package digest builds the weekly training summary users can opt in to and
+// emails it to them once their week has ended in their own timezone.
+package digest
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/config"
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/mail"
+	"github.com/neilZon/workout-logger-api/outbox"
+	"gorm.io/gorm"
+)
+
+// Digest summarises a user's training over a week
+type Digest struct {
+	// the week is WeekStart up to but not including WeekEnd
+	WeekStart         time.Time
+	WeekEnd           time.Time
+	SessionsCompleted int
+	TotalVolume       float64
+	PersonalBests     []database.PersonalBest
+	// nil when the user has no active routines
+	NextRoutine *database.WorkoutRoutine
+}
+
+// Location loads a user's timezone, UTC is used if it is unknown
+func Location(timezone string) *time.Location {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// LastWeekEnd is midnight at the start of the monday of the week now is in,
+// which is when the previous week ended
+func LastWeekEnd(now time.Time, loc *time.Location) time.Time {
+	t := now.In(loc)
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, loc)
+}
+
+// Build summarises the week ending at weekEnd
+func Build(db *gorm.DB, userId uint, weekEnd time.Time) (*Digest, error) {
+	// AddDate keeps the wall clock so weeks with a daylight saving change still start at midnight
+	weekStart := weekEnd.AddDate(0, 0, -7)
+
+	totals, err := database.GetTrainingTotals(db, userId, weekStart, weekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	bests, err := database.GetPersonalBests(db, userId, weekStart, weekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Digest{
+		WeekStart:         weekStart,
+		WeekEnd:           weekEnd,
+		SessionsCompleted: totals.Sessions,
+		TotalVolume:       totals.Volume,
+		PersonalBests:     bests,
+	}
+
+	next, err := database.GetNextWorkoutRoutine(db, userId)
+	if err == nil {
+		d.NextRoutine = next
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Email converts a digest to what the weekly digest email shows
+func (d *Digest) Email(name string) *mail.WeeklyDigest {
+	email := &mail.WeeklyDigest{
+		Name:      name,
+		WeekStart: d.WeekStart,
+		WeekEnd:   d.WeekEnd.AddDate(0, 0, -1),
+		Sessions:  d.SessionsCompleted,
+		Volume:    d.TotalVolume,
+	}
+	for _, pb := range d.PersonalBests {
+		email.PersonalBests = append(email.PersonalBests, mail.DigestPersonalBest{
+			Exercise:       pb.Name,
+			Weight:         pb.Weight,
+			PreviousWeight: pb.PreviousWeight,
+		})
+	}
+	if d.NextRoutine != nil {
+		email.NextRoutine = d.NextRoutine.Name
+	}
+	return email
+}
+
+// Scheduler emails each opted in user their digest once their week is over
+type Scheduler struct {
+	DB     *gorm.DB
+	Mailer mail.Mailer
+
+	now func() time.Time
+}
+
+func NewScheduler(db *gorm.DB, mailer mail.Mailer) *Scheduler {
+	return &Scheduler{DB: db, Mailer: mailer, now: time.Now}
+}
+
+// Run sends due digests every interval until ctx is cancelled
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.SendDue(); err != nil {
+			log.Printf("could not send weekly digests: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDue sends every digest that is due and returns how many were sent
+func (s *Scheduler) SendDue() (int, error) {
+	now := s.now()
+
+	// digests are a week apart so anyone sent one in the last six days isn't due
+	users, err := database.GetDigestRecipients(s.DB, now.Add(-6*24*time.Hour))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, u := range users {
+		weekEnd := LastWeekEnd(now, Location(u.Timezone))
+		dueAt := weekEnd.Add(config.DIGEST_SEND_DELAY)
+		if now.Before(dueAt) {
+			continue
+		}
+
+		ok, err := s.send(&u, weekEnd, dueAt, now)
+		if err != nil {
+			log.Printf("could not send weekly digest to user %d: %v", u.ID, err)
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// send claims and sends one user's digest, returns false if another
+// scheduler already sent it
+func (s *Scheduler) send(u *database.User, weekEnd time.Time, dueAt time.Time, now time.Time) (bool, error) {
+	claimed := false
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		ok, err := database.ClaimDigest(tx, u.ID, dueAt, now)
+		if err != nil || !ok {
+			return err
+		}
+
+		d, err := Build(tx, u.ID, weekEnd)
+		if err != nil {
+			return err
+		}
+
+		claimed = true
+		return mail.SendWeeklyDigest(outbox.ForTx(s.Mailer, tx), d.Email(u.Name), *u.DigestUnsubscribeCode, u.Email, u.Locale)
+	})
+	return claimed && err == nil, err
+}
@@ -0,0 +1,142 @@
+package digest
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/neilZon/workout-logger-api/mail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// helpers.SetupMockDB can't be used here since helpers depends on this package
+func setupMockDB() (sqlmock.Sqlmock, *gorm.DB) {
+	mockDb, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: mockDb,
+	}), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+
+	return mock, gormDB
+}
+
+func TestLastWeekEnd(t *testing.T) {
+	newYork := Location("America/New_York")
+
+	t.Run("Is midnight on monday", func(t *testing.T) {
+		// a wednesday
+		now := time.Date(2023, 5, 10, 15, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC), LastWeekEnd(now, time.UTC))
+	})
+
+	t.Run("Uses the user's timezone", func(t *testing.T) {
+		// monday in utc but still sunday in new york
+		now := time.Date(2023, 5, 8, 2, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2023, 5, 1, 0, 0, 0, 0, newYork), LastWeekEnd(now, newYork))
+	})
+
+	t.Run("Unknown timezones are utc", func(t *testing.T) {
+		assert.Equal(t, time.UTC, Location("Mars/Olympus_Mons"))
+	})
+}
+
+func TestScheduler(t *testing.T) {
+	recipientColumns := []string{"id", "name", "email", "locale", "timezone", "digest_enabled", "digest_unsubscribe_code"}
+
+	t.Run("Sends digests that are due", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+		recorder := mail.NewRecorder()
+		s := NewScheduler(gormDB, recorder)
+		// monday 9am in utc
+		now := time.Date(2023, 5, 8, 9, 0, 0, 0, time.UTC)
+		s.now = func() time.Time { return now }
+		weekEnd := time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC)
+		weekStart := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE (digest_enabled AND verified`)).
+			WithArgs(now.Add(-6 * 24 * time.Hour)).
+			WillReturnRows(sqlmock.NewRows(recipientColumns).AddRow(12, "lifter", "lifter@test.com", "en", "UTC", true, "unsubcode"))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "digest_sent_at"=$1,"updated_at"=$2 WHERE (id = $3 AND digest_enabled AND (digest_sent_at IS NULL OR digest_sent_at < $4))`)).
+			WithArgs(now, sqlmock.AnyArg(), 12, weekEnd.Add(8*time.Hour)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(DISTINCT workout_sessions.id) AS sessions`)).
+			WithArgs(uint(12), weekStart, weekEnd).
+			WillReturnRows(sqlmock.NewRows([]string{"sessions", "volume"}).AddRow(3, 10500))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT bests.exercise_routine_id`)).
+			WithArgs(weekStart, weekStart, uint(12), weekEnd).
+			WillReturnRows(sqlmock.NewRows([]string{"exercise_routine_id", "name", "weight", "previous_weight"}).AddRow(9, "Squat", 230, 225))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT workout_routines.* FROM "workout_routines" LEFT JOIN workout_sessions`)).
+			WithArgs(uint(12)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "active"}).AddRow(4, "Legs", true))
+		mock.ExpectCommit()
+
+		sent, err := s.SendDue()
+		require.Nil(t, err)
+		assert.Equal(t, 1, sent)
+
+		require.Len(t, recorder.Messages(), 1)
+		msg := recorder.Messages()[0]
+		assert.Equal(t, []string{"lifter@test.com"}, msg.To)
+		assert.Equal(t, "Your Week In Training!", msg.Subject)
+		assert.Contains(t, msg.Text, "May 1, 2023 to May 7, 2023")
+		assert.Contains(t, msg.Text, "Sessions completed: 3")
+		assert.Contains(t, msg.Text, "Total volume: 10500")
+		assert.Contains(t, msg.Text, "- Squat: 230 (up from 225)")
+		assert.Contains(t, msg.Text, "Up next: Legs")
+		assert.Contains(t, msg.Text, "/unsubscribe?code=unsubcode")
+
+		require.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Waits until the week is over in the user's timezone", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+		recorder := mail.NewRecorder()
+		s := NewScheduler(gormDB, recorder)
+		// monday 9am in utc is monday 3am in denver
+		now := time.Date(2023, 5, 8, 9, 0, 0, 0, time.UTC)
+		s.now = func() time.Time { return now }
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users"`)).
+			WillReturnRows(sqlmock.NewRows(recipientColumns).AddRow(12, "lifter", "lifter@test.com", "en", "America/Denver", true, "unsubcode"))
+
+		sent, err := s.SendDue()
+		require.Nil(t, err)
+		assert.Equal(t, 0, sent)
+		assert.Empty(t, recorder.Messages())
+
+		require.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Skips digests another scheduler sent", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+		recorder := mail.NewRecorder()
+		s := NewScheduler(gormDB, recorder)
+		now := time.Date(2023, 5, 8, 9, 0, 0, 0, time.UTC)
+		s.now = func() time.Time { return now }
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users"`)).
+			WillReturnRows(sqlmock.NewRows(recipientColumns).AddRow(12, "lifter", "lifter@test.com", "en", "UTC", true, "unsubcode"))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "digest_sent_at"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		sent, err := s.SendDue()
+		require.Nil(t, err)
+		assert.Equal(t, 0, sent)
+		assert.Empty(t, recorder.Messages())
+
+		require.Nil(t, mock.ExpectationsWereMet())
+	})
+}
@@ -9,7 +9,7 @@ const (
 	DeleteWorkoutRoutineError = "Could not delete workout routine, %s"
 
 	CreateWorkoutSessionError = "Could not create workout session, %s"
-	GetWorkoutSessionsError   = "Could not get workout sessions, %s"
+	GetWorkoutSessionsError   = "Could not get workout sessions"
 	GetWorkoutSessionError    = "Could not get workout session, %s"
 	UpdateWorkoutSessionError = "Could not update session session, %s"
 	DeleteWorkoutSessionError = "Could not delete workout session, %s"
@@ -0,0 +1,22 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/neilZon/workout-logger-api/accesscontroller"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// accessError turns a failed access check into the error returned for action,
+// e.g. "Error Deleting Set: Not Found"
+func accessError(action string, err error) error {
+	var notFound *accesscontroller.NotFoundError
+	if errors.As(err, &notFound) {
+		return gqlerror.Errorf("%s: %s", action, notFound.Error())
+	}
+	var denied *accesscontroller.AccessDeniedError
+	if errors.As(err, &denied) {
+		return gqlerror.Errorf("%s: %s", action, denied.Error())
+	}
+	return gqlerror.Errorf(action)
+}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/neilZon/workout-logger-api/archive"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ExportAccount is the resolver for the exportAccount field.
+func (r *queryResolver) ExportAccount(ctx context.Context) (string, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	a, err := archive.Export(r.DB, u.ID)
+	if err != nil {
+		return "", gqlerror.Errorf("Error Exporting Account")
+	}
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		return "", gqlerror.Errorf("Error Exporting Account")
+	}
+	return string(data), nil
+}
+
+// ImportAccount is the resolver for the importAccount field.
+func (r *mutationResolver) ImportAccount(ctx context.Context, archiveArg string) (*model.AccountImport, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.AccountImport{}, err
+	}
+
+	a, err := archive.Parse([]byte(archiveArg))
+	if errors.Is(err, archive.ErrUnsupportedVersion) {
+		return &model.AccountImport{}, gqlerror.Errorf("Error Importing Account: Unsupported Archive Version")
+	}
+	var invalidRecordError *archive.InvalidRecordError
+	if errors.As(err, &invalidRecordError) {
+		return &model.AccountImport{}, gqlerror.Errorf("Error Importing Account: %s", invalidRecordError)
+	}
+	if err != nil {
+		return &model.AccountImport{}, gqlerror.Errorf("Error Importing Account: Invalid Archive")
+	}
+
+	summary, err := archive.Import(r.DB, u.ID, a)
+	if err != nil {
+		log.Printf("could not import account archive for user %d: %v", u.ID, err)
+		return &model.AccountImport{}, gqlerror.Errorf("Error Importing Account")
+	}
+
+	return &model.AccountImport{
+		WorkoutRoutines:  summary.WorkoutRoutines,
+		ExerciseRoutines: summary.ExerciseRoutines,
+		WorkoutSessions:  summary.WorkoutSessions,
+		Exercises:        summary.Exercises,
+		SetEntries:       summary.SetEntries,
+	}, nil
+}
@@ -0,0 +1,212 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/mail"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/utils"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"gorm.io/gorm"
+)
+
+// AdminVerifyUser is the resolver for the adminVerifyUser field.
+func (r *mutationResolver) AdminVerifyUser(ctx context.Context, userID string) (*model.AdminUser, error) {
+	admin, err := middleware.GetAdmin(ctx, r.DB)
+	if err != nil {
+		return &model.AdminUser{}, err
+	}
+
+	dbUser, err := r.getAdminTarget(userID, "Error Verifying User")
+	if err != nil {
+		return &model.AdminUser{}, err
+	}
+
+	err = database.MarkUserVerified(r.DB, dbUser.ID)
+	if err != nil {
+		return &model.AdminUser{}, gqlerror.Errorf("Error Verifying User")
+	}
+	log.Printf("admin %d verified user %d", admin.ID, dbUser.ID)
+
+	return r.reloadAdminUser(userID, "Error Verifying User")
+}
+
+// AdminDisableUser is the resolver for the adminDisableUser field.
+func (r *mutationResolver) AdminDisableUser(ctx context.Context, userID string) (*model.AdminUser, error) {
+	admin, err := middleware.GetAdmin(ctx, r.DB)
+	if err != nil {
+		return &model.AdminUser{}, err
+	}
+
+	dbUser, err := r.getAdminTarget(userID, "Error Disabling User")
+	if err != nil {
+		return &model.AdminUser{}, err
+	}
+	if dbUser.ID == admin.ID {
+		return &model.AdminUser{}, gqlerror.Errorf("Error Disabling User: Can't Disable Yourself")
+	}
+
+	err = database.DisableUser(r.DB, dbUser.ID)
+	if err != nil {
+		return &model.AdminUser{}, gqlerror.Errorf("Error Disabling User")
+	}
+	log.Printf("admin %d disabled user %d", admin.ID, dbUser.ID)
+
+	return r.reloadAdminUser(userID, "Error Disabling User")
+}
+
+// AdminEnableUser is the resolver for the adminEnableUser field.
+func (r *mutationResolver) AdminEnableUser(ctx context.Context, userID string) (*model.AdminUser, error) {
+	admin, err := middleware.GetAdmin(ctx, r.DB)
+	if err != nil {
+		return &model.AdminUser{}, err
+	}
+
+	dbUser, err := r.getAdminTarget(userID, "Error Enabling User")
+	if err != nil {
+		return &model.AdminUser{}, err
+	}
+
+	err = database.EnableUser(r.DB, dbUser.ID)
+	if err != nil {
+		return &model.AdminUser{}, gqlerror.Errorf("Error Enabling User")
+	}
+	log.Printf("admin %d enabled user %d", admin.ID, dbUser.ID)
+
+	return r.reloadAdminUser(userID, "Error Enabling User")
+}
+
+// AdminForcePasswordReset is the resolver for the adminForcePasswordReset field.
+func (r *mutationResolver) AdminForcePasswordReset(ctx context.Context, userID string) (bool, error) {
+	admin, err := middleware.GetAdmin(ctx, r.DB)
+	if err != nil {
+		return false, err
+	}
+
+	dbUser, err := r.getAdminTarget(userID, "Error Resetting Password")
+	if err != nil {
+		return false, err
+	}
+
+	passwordResetCode, err := utils.GenerateVerificationCode(64)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Resetting Password")
+	}
+
+	err = database.ForcePasswordReset(r.DB, dbUser.ID, passwordResetCode)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Resetting Password")
+	}
+	log.Printf("admin %d forced a password reset for user %d", admin.ID, dbUser.ID)
+
+	err = mail.SendResetLink(r.Mailer, passwordResetCode, dbUser.Email, dbUser.Locale)
+	if err != nil {
+		return false, gqlerror.Errorf("Password Cleared But Reset Email Failed To Send")
+	}
+
+	return true, nil
+}
+
+// AdminUsers is the resolver for the adminUsers field.
+func (r *queryResolver) AdminUsers(ctx context.Context, search *string, limit int, after *string) (*model.AdminUserConnection, error) {
+	_, err := middleware.GetAdmin(ctx, r.DB)
+	if err != nil {
+		return &model.AdminUserConnection{}, err
+	}
+
+	if limit <= 0 || limit > 50 {
+		return &model.AdminUserConnection{}, gqlerror.Errorf("Error Getting Users: limit needs to be between 1 to 50")
+	}
+
+	query := ""
+	if search != nil {
+		query = *search
+	}
+	cursor := ""
+	if after != nil && *after != "" {
+		cursor = *after
+	}
+
+	// fetch one extra to know if there is another page
+	dbUsers, err := database.SearchUsers(r.DB, query, cursor, limit+1)
+	if err != nil {
+		return &model.AdminUserConnection{}, gqlerror.Errorf("Error Getting Users")
+	}
+	hasNextPage := len(dbUsers) > limit
+	if hasNextPage {
+		dbUsers = dbUsers[:limit]
+	}
+
+	edges := make([]*model.AdminUserEdge, 0)
+	for i := range dbUsers {
+		edges = append(edges, &model.AdminUserEdge{
+			Cursor: utils.UIntToString(dbUsers[i].ID),
+			Node:   adminUserToModel(&dbUsers[i]),
+		})
+	}
+
+	return &model.AdminUserConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			HasNextPage: hasNextPage,
+		},
+	}, nil
+}
+
+// AdminUser is the resolver for the adminUser field.
+func (r *queryResolver) AdminUser(ctx context.Context, userID string) (*model.AdminUser, error) {
+	_, err := middleware.GetAdmin(ctx, r.DB)
+	if err != nil {
+		return &model.AdminUser{}, err
+	}
+
+	dbUser, err := r.getAdminTarget(userID, "Error Getting User")
+	if err != nil {
+		return &model.AdminUser{}, err
+	}
+
+	return adminUserToModel(dbUser), nil
+}
+
+// getAdminTarget looks up the user an admin is acting on
+func (r *Resolver) getAdminTarget(userID string, errMsg string) (*database.User, error) {
+	dbUser, err := database.GetUserById(r.DB, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, gqlerror.Errorf("%s: User Not Found", errMsg)
+	}
+	if err != nil {
+		return nil, gqlerror.Errorf(errMsg)
+	}
+	return dbUser, nil
+}
+
+func (r *Resolver) reloadAdminUser(userID string, errMsg string) (*model.AdminUser, error) {
+	dbUser, err := r.getAdminTarget(userID, errMsg)
+	if err != nil {
+		return &model.AdminUser{}, err
+	}
+	return adminUserToModel(dbUser), nil
+}
+
+func adminUserToModel(u *database.User) *model.AdminUser {
+	role := u.Role
+	if role == "" {
+		role = "user"
+	}
+	return &model.AdminUser{
+		ID:                 utils.UIntToString(u.ID),
+		Name:               u.Name,
+		Email:              u.Email,
+		Role:               role,
+		Verified:           u.Verified,
+		VerificationSentAt: u.VerificationSentAt,
+		Disabled:           u.DisabledAt != nil,
+		LockedUntil:        u.LockedUntil,
+		TotpEnabled:        u.TotpEnabled,
+		CreatedAt:          u.CreatedAt,
+	}
+}
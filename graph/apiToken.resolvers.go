@@ -0,0 +1,120 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/token"
+	"github.com/neilZon/workout-logger-api/utils"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// CreateAPIToken is the resolver for the createApiToken field.
+func (r *mutationResolver) CreateAPIToken(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*model.NewAPIToken, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.NewAPIToken{}, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 64 {
+		return &model.NewAPIToken{}, gqlerror.Errorf("Name needs to be between 1 and 64 characters")
+	}
+
+	granted := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, s := range scopes {
+		if !token.ValidScope(s) {
+			return &model.NewAPIToken{}, gqlerror.Errorf("Unknown scope %q", s)
+		}
+		if !seen[s] {
+			seen[s] = true
+			granted = append(granted, s)
+		}
+	}
+	if len(granted) == 0 {
+		return &model.NewAPIToken{}, gqlerror.Errorf("Api token needs at least one scope")
+	}
+
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return &model.NewAPIToken{}, gqlerror.Errorf("Expiry needs to be in the future")
+	}
+
+	plaintext, hash, prefix, err := token.GenerateApiToken()
+	if err != nil {
+		return &model.NewAPIToken{}, gqlerror.Errorf("Error Creating Api Token")
+	}
+
+	dbApiToken := &database.ApiToken{
+		Name:      name,
+		TokenHash: hash,
+		Prefix:    prefix,
+		Scopes:    strings.Join(granted, " "),
+		ExpiresAt: expiresAt,
+		UserID:    u.ID,
+	}
+	err = database.CreateApiToken(r.DB, dbApiToken)
+	if err != nil {
+		return &model.NewAPIToken{}, gqlerror.Errorf("Error Creating Api Token")
+	}
+
+	return &model.NewAPIToken{
+		Token:    plaintext,
+		APIToken: apiTokenToModel(dbApiToken),
+	}, nil
+}
+
+// RevokeAPIToken is the resolver for the revokeApiToken field.
+func (r *mutationResolver) RevokeAPIToken(ctx context.Context, apiTokenID string) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	revoked, err := database.RevokeApiToken(r.DB, apiTokenID, u.ID)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Revoking Api Token")
+	}
+	if !revoked {
+		return false, gqlerror.Errorf("Error Revoking Api Token: Access Denied")
+	}
+
+	return true, nil
+}
+
+// APITokens is the resolver for the apiTokens field.
+func (r *queryResolver) APITokens(ctx context.Context) ([]*model.APIToken, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return []*model.APIToken{}, err
+	}
+
+	dbApiTokens, err := database.GetApiTokens(r.DB, fmt.Sprintf("%d", u.ID))
+	if err != nil {
+		return []*model.APIToken{}, gqlerror.Errorf("Error Getting Api Tokens")
+	}
+
+	apiTokens := make([]*model.APIToken, 0)
+	for i := range dbApiTokens {
+		apiTokens = append(apiTokens, apiTokenToModel(&dbApiTokens[i]))
+	}
+
+	return apiTokens, nil
+}
+
+func apiTokenToModel(t *database.ApiToken) *model.APIToken {
+	return &model.APIToken{
+		ID:         utils.UIntToString(t.ID),
+		Name:       t.Name,
+		Prefix:     t.Prefix,
+		Scopes:     strings.Fields(t.Scopes),
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+	}
+}
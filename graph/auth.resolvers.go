@@ -2,21 +2,23 @@ package graph
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
-	"os"
+	"strings"
 	"time"
 
+	"github.com/neilZon/workout-logger-api/common"
 	"github.com/neilZon/workout-logger-api/config"
 	"github.com/neilZon/workout-logger-api/database"
 	"github.com/neilZon/workout-logger-api/graph/model"
 	"github.com/neilZon/workout-logger-api/mail"
 	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/oidc"
 	"github.com/neilZon/workout-logger-api/token"
 	"github.com/neilZon/workout-logger-api/utils"
 	"github.com/neilZon/workout-logger-api/validator"
 	"github.com/vektah/gqlparser/v2/gqlerror"
-	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
@@ -27,6 +29,11 @@ func (r *mutationResolver) Login(ctx context.Context, loginInput model.LoginInpu
 		return &model.AuthResult{}, gqlerror.Errorf("invalid email")
 	}
 
+	err = r.checkRateLimit(ctx, loginRateLimit, loginInput.Email)
+	if err != nil {
+		return &model.AuthResult{}, err
+	}
+
 	dbUser, err := database.GetUserByEmail(r.DB, loginInput.Email)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return &model.AuthResult{}, gqlerror.Errorf("Email does not exist")
@@ -40,27 +47,25 @@ func (r *mutationResolver) Login(ctx context.Context, loginInput model.LoginInpu
 		return &model.AuthResult{}, err
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(dbUser.Password), []byte(loginInput.Password)); err != nil {
-		return &model.AuthResult{}, gqlerror.Errorf("Incorrect Password")
-	}
-	c := &token.Credentials{
-		ID:    dbUser.ID,
-		Email: dbUser.Email,
-		Name:  dbUser.Name,
+	err = r.checkPassword(dbUser, loginInput.Password, "Error Logging In")
+	if err != nil {
+		return &model.AuthResult{}, err
 	}
 
-	refreshToken := token.Sign(c, []byte(os.Getenv(config.REFRESH_SECRET)), config.REFRESH_TTL)
-	accessToken := token.Sign(c, []byte(os.Getenv(config.ACCESS_SECRET)), config.ACCESS_TTL)
-
-	return &model.AuthResult{
-		RefreshToken: refreshToken,
-		AccessToken:  accessToken,
-	}, nil
+	authResult, err := r.finishLogin(ctx, dbUser, loginInput.DeviceName)
+	if err != nil {
+		return &model.AuthResult{}, err
+	}
+	return authResult, nil
 }
 
 // Signup is the resolver for the signup field.
 func (r *mutationResolver) Signup(ctx context.Context, signupInput model.SignupInput) (*model.AuthResult, error) {
-	if err := validator.SignupInputIsValid(&signupInput); err != nil {
+	if err := validator.SignupInputIsValid(&signupInput, r.PasswordPolicy); err != nil {
+		return &model.AuthResult{}, err
+	}
+
+	if err := r.checkRateLimit(ctx, signupRateLimit, signupInput.Email); err != nil {
 		return &model.AuthResult{}, err
 	}
 
@@ -73,34 +78,41 @@ func (r *mutationResolver) Signup(ctx context.Context, signupInput model.SignupI
 		return &model.AuthResult{}, gqlerror.Errorf("email already exists")
 	}
 
-	// Hashing the password with the default cost of 10
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(signupInput.Password), bcrypt.DefaultCost)
+	hashedPassword, err := r.Hasher.Hash(signupInput.Password)
 	if err != nil {
-		panic(err)
+		return &model.AuthResult{}, gqlerror.Errorf("error signing up")
 	}
 
 	verificationCode, err := utils.GenerateVerificationCode(64)
 	if err != nil {
 		return &model.AuthResult{}, gqlerror.Errorf(err.Error())
 	}
+	verificationPin, err := utils.GenerateNumericCode(6)
+	if err != nil {
+		return &model.AuthResult{}, gqlerror.Errorf("error signing up")
+	}
 	now := time.Now()
 	u := database.User{
 		Name:               signupInput.Name,
 		Email:              signupInput.Email,
-		Password:           string(hashedPassword),
+		Password:           hashedPassword,
 		VerificationCode:   &verificationCode,
+		VerificationPin:    &verificationPin,
 		Verified:           false,
 		VerificationSentAt: &now,
 	}
-	err = r.DB.Create(&u).Error
-	if err != nil {
-		return &model.AuthResult{}, gqlerror.Errorf(err.Error())
+	if signupInput.Locale != nil {
+		u.Locale = *signupInput.Locale
 	}
-
-	// should this be moved to inside the user create tx?
-	err = mail.SendVerificationCode(verificationCode, u.Email)
+	// the verification email is queued with the user so neither exists without the other
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&u).Error; err != nil {
+			return err
+		}
+		return mail.SendVerificationCode(r.txMailer(tx), verificationCode, verificationPin, u.Email, u.Locale)
+	})
 	if err != nil {
-		return &model.AuthResult{}, gqlerror.Errorf("Issue sending verification email")
+		return &model.AuthResult{}, gqlerror.Errorf("error signing up")
 	}
 
 	c := &token.Credentials{
@@ -109,42 +121,297 @@ func (r *mutationResolver) Signup(ctx context.Context, signupInput model.SignupI
 		Name:  u.Name,
 	}
 
-	refreshToken := token.Sign(c, []byte(os.Getenv(config.REFRESH_SECRET)), config.REFRESH_TTL)
-	accessToken := token.Sign(c, []byte(os.Getenv(config.ACCESS_SECRET)), config.ACCESS_TTL)
+	accessToken, refreshToken, err := issueTokens(ctx, r.DB, r.Keys, c, signupInput.DeviceName)
+	if err != nil {
+		return &model.AuthResult{}, gqlerror.Errorf("error signing up")
+	}
 
 	return &model.AuthResult{
-		RefreshToken: refreshToken,
-		AccessToken:  accessToken,
+		RefreshToken: &refreshToken,
+		AccessToken:  &accessToken,
 	}, nil
 }
 
+// LoginWithOidc is the resolver for the loginWithOidc field.
+func (r *mutationResolver) LoginWithOidc(ctx context.Context, provider string, idToken string, nonce string, deviceName *string) (*model.AuthResult, error) {
+	identity, err := r.OIDC.Verify(ctx, provider, idToken, nonce)
+	if errors.Is(err, oidc.ErrUnknownProvider) {
+		return &model.AuthResult{}, gqlerror.Errorf("Unknown provider")
+	}
+	if err != nil {
+		return &model.AuthResult{}, gqlerror.Errorf("Id token invalid")
+	}
+
+	used, err := database.UseOidcNonce(r.DB, nonce, time.Now())
+	if err != nil {
+		return &model.AuthResult{}, gqlerror.Errorf("Error Logging In")
+	}
+	if !used {
+		return &model.AuthResult{}, gqlerror.Errorf("Id token invalid")
+	}
+
+	dbUser, err := database.GetUserByIdentity(r.DB, identity.Provider, identity.Subject)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		dbUser, err = r.linkOidcUser(identity)
+	}
+	if err != nil {
+		return &model.AuthResult{}, err
+	}
+
+	authResult, err := r.finishLogin(ctx, dbUser, deviceName)
+	if err != nil {
+		return &model.AuthResult{}, err
+	}
+	return authResult, nil
+}
+
+// OidcNonce is the resolver for the oidcNonce field.
+func (r *mutationResolver) OidcNonce(ctx context.Context) (string, error) {
+	err := r.Limiter.Allow(ctx, "oidc:ip:"+middleware.GetClient(ctx).IP, oidcNonceRateLimit)
+	var rateLimitedError *common.RateLimitedError
+	if errors.As(err, &rateLimitedError) {
+		return "", err
+	}
+	if err != nil {
+		return "", gqlerror.Errorf("Error Processing Request")
+	}
+
+	nonce, err := utils.GenerateVerificationCode(32)
+	if err != nil {
+		return "", gqlerror.Errorf("Error Processing Request")
+	}
+	err = database.CreateOidcNonce(r.DB, nonce, time.Now().Add(config.OIDC_NONCE_TTL))
+	if err != nil {
+		return "", gqlerror.Errorf("Error Processing Request")
+	}
+	return nonce, nil
+}
+
+// linkOidcUser finds the user a first time provider sign in belongs to, by
+// email when the provider has verified it, creating the user if there isn't one
+func (r *mutationResolver) linkOidcUser(identity *oidc.Identity) (*database.User, error) {
+	if identity.Email == "" {
+		return nil, gqlerror.Errorf("Provider did not share an email")
+	}
+
+	dbUser, err := database.GetUserByEmail(r.DB, identity.Email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, gqlerror.Errorf("Error Logging In")
+	}
+
+	if err == nil {
+		// otherwise anyone could claim an account by signing up at the provider with its email
+		if !identity.EmailVerified {
+			return nil, gqlerror.Errorf("email already exists")
+		}
+		err = database.LinkIdentity(r.DB, &database.Identity{
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+			UserID:   dbUser.ID,
+		}, true)
+		if err != nil {
+			return nil, gqlerror.Errorf("Error Logging In")
+		}
+		dbUser.Verified = true
+		return dbUser, nil
+	}
+
+	name := identity.Name
+	if name == "" {
+		name = strings.Split(identity.Email, "@")[0]
+	}
+	u := &database.User{
+		Name:  truncate(name, 50),
+		Email: identity.Email,
+		// no password until they set one with a reset link
+		Password: "",
+		Verified: identity.EmailVerified,
+	}
+	if !identity.EmailVerified {
+		verificationCode, err := utils.GenerateVerificationCode(64)
+		if err != nil {
+			return nil, gqlerror.Errorf("Error Logging In")
+		}
+		verificationPin, err := utils.GenerateNumericCode(6)
+		if err != nil {
+			return nil, gqlerror.Errorf("Error Logging In")
+		}
+		now := time.Now()
+		u.VerificationCode = &verificationCode
+		u.VerificationPin = &verificationPin
+		u.VerificationSentAt = &now
+	}
+
+	// the verification email is queued with the user so neither exists without the other
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		err := database.CreateUserWithIdentity(tx, u, &database.Identity{
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+		})
+		if err != nil || u.VerificationCode == nil {
+			return err
+		}
+		return mail.SendVerificationCode(r.txMailer(tx), *u.VerificationCode, *u.VerificationPin, u.Email, u.Locale)
+	})
+	if err != nil {
+		return nil, gqlerror.Errorf("Error Logging In")
+	}
+	return u, nil
+}
+
+// RequestLoginLink is the resolver for the requestLoginLink field.
+func (r *mutationResolver) RequestLoginLink(ctx context.Context, email string) (bool, error) {
+	err := validator.ValidateEmail(email)
+	if err != nil {
+		return false, gqlerror.Errorf("not a valid email")
+	}
+
+	err = r.checkRateLimit(ctx, sendEmailRateLimit, email)
+	if err != nil {
+		return false, err
+	}
+
+	// check if user exists to send email to
+	dbUser, err := database.GetUserByEmail(r.DB, email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, gqlerror.Errorf("user does not exist")
+	}
+	if err != nil {
+		return false, gqlerror.Errorf("error sending login link")
+	}
+
+	loginLinkCode, err := utils.GenerateVerificationCode(32)
+	if err != nil {
+		return false, gqlerror.Errorf("error sending login link")
+	}
+
+	// replaces any link sent before so only the newest one works
+	now := time.Now()
+	u := database.User{
+		LoginLinkCode:   &loginLinkCode,
+		LoginLinkSentAt: &now,
+	}
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := database.UpdateUser(tx, email, &u); err != nil {
+			return err
+		}
+		return mail.SendLoginLink(r.txMailer(tx), loginLinkCode, email, dbUser.Locale)
+	})
+	if err != nil {
+		return false, gqlerror.Errorf("error sending login link")
+	}
+
+	return true, nil
+}
+
+// LoginWithLink is the resolver for the loginWithLink field.
+func (r *mutationResolver) LoginWithLink(ctx context.Context, code string, deviceName *string) (*model.AuthResult, error) {
+	dbUser, err := database.ConsumeLoginLink(r.DB, code, time.Now().Add(-config.LOGIN_LINK_TTL))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.AuthResult{}, gqlerror.Errorf("Login link invalid or expired")
+	}
+	if err != nil {
+		return &model.AuthResult{}, gqlerror.Errorf("Error Logging In")
+	}
+
+	authResult, err := r.finishLogin(ctx, dbUser, deviceName)
+	if err != nil {
+		return &model.AuthResult{}, err
+	}
+	return authResult, nil
+}
+
 // RefreshAccessToken is the resolver for the refreshAccessToken field.
 func (r *mutationResolver) RefreshAccessToken(ctx context.Context, refreshToken string) (*model.RefreshSuccess, error) {
 	// read token from context
-	claims, err := token.Decode(refreshToken, []byte(os.Getenv(config.REFRESH_SECRET)))
+	claims, err := r.Keys.Refresh.Decode(refreshToken)
+	if err != nil || claims.Id == "" {
+		return nil, gqlerror.Errorf("Refresh token invalid")
+	}
+
+	current, err := database.GetRefreshToken(r.DB, claims.Id)
 	if err != nil {
 		return nil, gqlerror.Errorf("Refresh token invalid")
 	}
+	if current.RevokedAt != nil || current.ExpiresAt.Before(time.Now()) {
+		return nil, gqlerror.Errorf("Refresh token invalid")
+	}
 
 	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", claims.ID))
 	if err != nil {
 		return &model.RefreshSuccess{}, err
 	}
 
-	accessToken := token.Sign(&token.Credentials{
+	next, err := newRefreshToken(current.UserID, current.SessionID)
+	if err != nil {
+		return nil, gqlerror.Errorf("Error Refreshing Token")
+	}
+
+	err = database.RotateRefreshToken(r.DB, current, next)
+	if errors.Is(err, database.ErrRefreshTokenReused) {
+		// a rotated token being replayed means it may have leaked,
+		// so nothing issued from this login can be trusted anymore
+		err = database.RevokeSession(r.DB, current.SessionID)
+		if err != nil {
+			return nil, gqlerror.Errorf("Error Refreshing Token")
+		}
+		return nil, gqlerror.Errorf("Refresh token invalid")
+	}
+	if err != nil {
+		return nil, gqlerror.Errorf("Error Refreshing Token")
+	}
+
+	accessToken, newRefreshToken, err := signTokens(r.Keys, &token.Credentials{
 		ID:    claims.ID,
 		Email: claims.Subject,
 		Name:  claims.Name,
-	},
-		[]byte(os.Getenv(config.ACCESS_SECRET)),
-		config.ACCESS_TTL,
-	)
+		Role:  claims.Role,
+	}, next)
+	if err != nil {
+		return nil, gqlerror.Errorf("Error Refreshing Token")
+	}
 
 	return &model.RefreshSuccess{
-		AccessToken: accessToken,
+		AccessToken:  accessToken,
+		RefreshToken: newRefreshToken,
 	}, nil
 }
 
+// Logout is the resolver for the logout field.
+func (r *mutationResolver) Logout(ctx context.Context, refreshToken string) (bool, error) {
+	claims, err := r.Keys.Refresh.Decode(refreshToken)
+	if err != nil || claims.Id == "" {
+		return false, gqlerror.Errorf("Refresh token invalid")
+	}
+
+	rt, err := database.GetRefreshToken(r.DB, claims.Id)
+	if err != nil {
+		return false, gqlerror.Errorf("Refresh token invalid")
+	}
+
+	err = database.RevokeSession(r.DB, rt.SessionID)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Logging Out")
+	}
+
+	return true, nil
+}
+
+// LogoutEverywhere is the resolver for the logoutEverywhere field.
+func (r *mutationResolver) LogoutEverywhere(ctx context.Context) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	err = database.RevokeUserSessions(r.DB, fmt.Sprintf("%d", u.ID))
+	if err != nil {
+		return false, gqlerror.Errorf("Error Logging Out")
+	}
+
+	return true, nil
+}
+
 // ResendVerificationCode is the resolver for the resendVerificationCode field.
 func (r *mutationResolver) ResendVerificationCode(ctx context.Context, email string) (bool, error) {
 	err := validator.ValidateEmail(email)
@@ -152,8 +419,13 @@ func (r *mutationResolver) ResendVerificationCode(ctx context.Context, email str
 		return false, gqlerror.Errorf(err.Error())
 	}
 
+	err = r.checkRateLimit(ctx, sendEmailRateLimit, email)
+	if err != nil {
+		return false, err
+	}
+
 	// check if user exists to send email to
-	_, err = database.GetUserByEmail(r.DB, email)
+	dbUser, err := database.GetUserByEmail(r.DB, email)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return false, gqlerror.Errorf("user does not exist")
 	}
@@ -165,19 +437,18 @@ func (r *mutationResolver) ResendVerificationCode(ctx context.Context, email str
 	if err != nil {
 		return false, gqlerror.Errorf("could not send verification email")
 	}
-
-	now := time.Now()
-	u := database.User{
-		VerificationCode:   &verificationCode,
-		VerificationSentAt: &now,
-	}
-	err = database.UpdateUser(r.DB, email, &u)
+	verificationPin, err := utils.GenerateNumericCode(6)
 	if err != nil {
 		return false, gqlerror.Errorf("could not send verification email")
 	}
 
-	// should this be moved to inside the user create tx?
-	err = mail.SendVerificationCode(verificationCode, email)
+	// replaces the link and pin sent before, along with the guesses made at the pin
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := database.SetVerificationCodes(tx, email, verificationCode, verificationPin, time.Now()); err != nil {
+			return err
+		}
+		return mail.SendVerificationCode(r.txMailer(tx), verificationCode, verificationPin, email, dbUser.Locale)
+	})
 	if err != nil {
 		return false, gqlerror.Errorf("could not send verification email")
 	}
@@ -185,6 +456,41 @@ func (r *mutationResolver) ResendVerificationCode(ctx context.Context, email str
 	return true, nil
 }
 
+// VerifyEmail is the resolver for the verifyEmail field.
+func (r *mutationResolver) VerifyEmail(ctx context.Context, email string, code string) (bool, error) {
+	err := validator.ValidateEmail(email)
+	if err != nil {
+		return false, gqlerror.Errorf("not a valid email")
+	}
+
+	err = r.checkRateLimit(ctx, verifyEmailRateLimit, email)
+	if err != nil {
+		return false, err
+	}
+
+	// the guess is counted before it is checked so concurrent guesses can't get around the limit
+	dbUser, err := database.RecordVerificationPinAttempt(r.DB, email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, gqlerror.Errorf("Verification code invalid or expired")
+	}
+	if err != nil {
+		return false, gqlerror.Errorf("Error Verifying Email")
+	}
+
+	expired := dbUser.VerificationSentAt == nil || time.Since(*dbUser.VerificationSentAt) > config.VERIFICATION_PIN_TTL
+	if expired || dbUser.VerificationPinAttempts > config.MAX_VERIFICATION_PIN_ATTEMPTS ||
+		subtle.ConstantTimeCompare([]byte(*dbUser.VerificationPin), []byte(code)) != 1 {
+		return false, gqlerror.Errorf("Verification code invalid or expired")
+	}
+
+	err = database.MarkUserVerified(r.DB, dbUser.ID)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Verifying Email")
+	}
+
+	return true, nil
+}
+
 // SendForgotPasswordLink is the resolver for the sendForgotPasswordLink field.
 func (r *mutationResolver) SendForgotPasswordLink(ctx context.Context, email string) (bool, error) {
 	err := validator.ValidateEmail(email)
@@ -192,8 +498,13 @@ func (r *mutationResolver) SendForgotPasswordLink(ctx context.Context, email str
 		return false, gqlerror.Errorf("not a valid email")
 	}
 
+	err = r.checkRateLimit(ctx, sendEmailRateLimit, email)
+	if err != nil {
+		return false, err
+	}
+
 	// check if user exists to send email to
-	_, err = database.GetUserByEmail(r.DB, email)
+	dbUser, err := database.GetUserByEmail(r.DB, email)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return false, gqlerror.Errorf("user does not exist")
 	}
@@ -211,12 +522,12 @@ func (r *mutationResolver) SendForgotPasswordLink(ctx context.Context, email str
 		PasswordResetCode:   &passwordResetCode,
 		PasswordResetSentAt: &now,
 	}
-	err = database.UpdateUser(r.DB, email, &u)
-	if err != nil {
-		return false, gqlerror.Errorf("error sending password reset code")
-	}
-
-	err = mail.SendResetLink(passwordResetCode, email)
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := database.UpdateUser(tx, email, &u); err != nil {
+			return err
+		}
+		return mail.SendResetLink(r.txMailer(tx), passwordResetCode, email, dbUser.Locale)
+	})
 	if err != nil {
 		return false, gqlerror.Errorf("error sending password reset code")
 	}
@@ -239,16 +550,58 @@ func (r *mutationResolver) ResetPassword(ctx context.Context, passwordResetCrede
 		return false, gqlerror.Errorf("could not reset password")
 	}
 
-	// Hashing the password with the default cost of 10
-	newHashedPassword, err := bcrypt.GenerateFromPassword([]byte(passwordResetCredentials.Password), bcrypt.DefaultCost)
+	err = r.PasswordPolicy.Validate(passwordResetCredentials.Password, user.Email, user.Name)
+	if err != nil {
+		return false, gqlerror.Errorf(err.Error())
+	}
+
+	newHashedPassword, err := r.Hasher.Hash(passwordResetCredentials.Password)
 	if err != nil {
 		return false, gqlerror.Errorf("could not reset password")
 	}
 
-	err = database.ChangePassword(r.DB, passwordResetCredentials.Code, string(newHashedPassword))
+	err = database.ChangePassword(r.DB, passwordResetCredentials.Code, newHashedPassword)
 	if err != nil {
 		return false, gqlerror.Errorf(err.Error())
 	}
 
 	return true, nil
 }
+
+// VerificationEmailStatus is the resolver for the verificationEmailStatus field.
+func (r *queryResolver) VerificationEmailStatus(ctx context.Context) (*model.EmailDelivery, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the address is looked up since it can have changed since the token was issued
+	dbUser, err := database.GetUserById(r.DB, utils.UIntToString(u.ID))
+	if err != nil {
+		return nil, gqlerror.Errorf("Error Getting Email Status")
+	}
+
+	queued, err := database.GetLatestEmail(r.DB, dbUser.Email, mail.KindVerification)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, gqlerror.Errorf("Error Getting Email Status")
+	}
+
+	delivery := &model.EmailDelivery{
+		Status:   model.EmailDeliveryStatusPending,
+		Attempts: queued.Attempts,
+		QueuedAt: queued.CreatedAt,
+		SentAt:   queued.SentAt,
+	}
+	switch queued.Status {
+	case database.OutboxSent:
+		delivery.Status = model.EmailDeliveryStatusSent
+	case database.OutboxDead:
+		delivery.Status = model.EmailDeliveryStatusFailed
+	default:
+		delivery.NextAttemptAt = &queued.NextAttemptAt
+	}
+	return delivery, nil
+}
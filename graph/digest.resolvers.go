@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/digest"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/utils"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ChangeDigestSettings is the resolver for the changeDigestSettings field.
+func (r *mutationResolver) ChangeDigestSettings(ctx context.Context, enabled bool, timezone string) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" || timezone == "Local" {
+		return false, gqlerror.Errorf("not a valid timezone")
+	}
+
+	// only used if the user doesn't have one from opting in before
+	unsubscribeCode, err := utils.GenerateVerificationCode(32)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Changing Digest Settings")
+	}
+
+	err = database.UpdateDigestSettings(r.DB, u.ID, enabled, timezone, unsubscribeCode, time.Now())
+	if err != nil {
+		return false, gqlerror.Errorf("Error Changing Digest Settings")
+	}
+
+	return true, nil
+}
+
+// WeeklyDigest is the resolver for the weeklyDigest field.
+func (r *queryResolver) WeeklyDigest(ctx context.Context) (*model.WeeklyDigest, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.WeeklyDigest{}, err
+	}
+
+	dbUser, err := database.GetUserById(r.DB, fmt.Sprintf("%d", u.ID))
+	if err != nil {
+		return &model.WeeklyDigest{}, gqlerror.Errorf("Error Getting Weekly Digest")
+	}
+
+	weekEnd := digest.LastWeekEnd(time.Now(), digest.Location(dbUser.Timezone))
+	d, err := digest.Build(r.DB, dbUser.ID, weekEnd)
+	if err != nil {
+		return &model.WeeklyDigest{}, gqlerror.Errorf("Error Getting Weekly Digest")
+	}
+
+	weeklyDigest := &model.WeeklyDigest{
+		WeekStart:         d.WeekStart,
+		WeekEnd:           d.WeekEnd,
+		SessionsCompleted: d.SessionsCompleted,
+		TotalVolume:       d.TotalVolume,
+		PersonalBests:     make([]*model.PersonalBest, 0),
+	}
+	for _, pb := range d.PersonalBests {
+		weeklyDigest.PersonalBests = append(weeklyDigest.PersonalBests, &model.PersonalBest{
+			ExerciseRoutineID: utils.UIntToString(pb.ExerciseRoutineID),
+			Name:              pb.Name,
+			Weight:            float64(pb.Weight),
+			PreviousWeight:    float64(pb.PreviousWeight),
+		})
+	}
+	if d.NextRoutine != nil {
+		weeklyDigest.NextRoutine = &model.WorkoutRoutine{
+			ID:     utils.UIntToString(d.NextRoutine.ID),
+			Name:   d.NextRoutine.Name,
+			Active: d.NextRoutine.Active,
+		}
+	}
+
+	return weeklyDigest, nil
+}
@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/neilZon/workout-logger-api/graph/generated"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"gorm.io/gorm"
+)
+
+// NewDirectives returns the handlers for the directives declared in schema.graphqls
+func NewDirectives(db *gorm.DB) generated.DirectiveRoot {
+	return generated.DirectiveRoot{
+		Auth: func(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+			_, err := middleware.GetUser(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return next(ctx)
+		},
+		Scope: func(ctx context.Context, obj interface{}, next graphql.Resolver, scope string) (interface{}, error) {
+			_, err := middleware.GetUserWithScope(ctx, scope)
+			if err != nil {
+				return nil, err
+			}
+			return next(ctx)
+		},
+		Verified: func(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+			err := middleware.IsVerified(ctx, db)
+			if err != nil {
+				return nil, err
+			}
+			return next(ctx)
+		},
+	}
+}
@@ -16,23 +16,22 @@ import (
 
 // AddExercise is the resolver for the addExercise field.
 func (r *mutationResolver) AddExercise(ctx context.Context, workoutSessionID string, exercise model.ExerciseInput) (*model.Exercise, error) {
-	u, err := middleware.GetUser(ctx)
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.Exercise{}, err
 	}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	userId := fmt.Sprintf("%d", u.ID)
+	err = r.ACS.CanAccessWorkoutSession(userId, workoutSessionID)
 	if err != nil {
-		return &model.Exercise{}, err
+		return &model.Exercise{}, accessError("Error Adding Exercise", err)
 	}
 
-	userId := fmt.Sprintf("%d", u.ID)
-	err = r.ACS.CanAccessWorkoutSession(userId, workoutSessionID)
+	err = r.ACS.CanAccessExerciseRoutine(userId, exercise.ExerciseRoutineID)
 	if err != nil {
-		return &model.Exercise{}, gqlerror.Errorf("Error Adding Exercise: %s", err.Error())
+		return &model.Exercise{}, accessError("Error Adding Exercise", err)
 	}
 
-	// todo: check can access exercise routines that are being added
 	if len(exercise.SetEntries) > 20 {
 		return &model.Exercise{}, gqlerror.Errorf("exercises can only have a maximum of 20 sets")
 	}
@@ -79,19 +78,19 @@ func (r *mutationResolver) AddExercise(ctx context.Context, workoutSessionID str
 
 // Exercise is the resolver for the exercise field.
 func (r *queryResolver) Exercise(ctx context.Context, exerciseID string) (*model.Exercise, error) {
-	u, err := middleware.GetUser(ctx)
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.Exercise{}, err
 	}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	exerciseIDUint, err := strconv.ParseUint(exerciseID, 10, 64)
 	if err != nil {
-		return &model.Exercise{}, err
+		return &model.Exercise{}, gqlerror.Errorf("Error Getting Exercise: Invalid Exercise ID")
 	}
 
-	exerciseIDUint, err := strconv.ParseUint(exerciseID, 10, 64)
+	err = r.ACS.CanAccessExercise(fmt.Sprintf("%d", u.ID), exerciseID)
 	if err != nil {
-		return &model.Exercise{}, gqlerror.Errorf("Error Getting Exercise: Invalid Exercise ID")
+		return &model.Exercise{}, accessError("Error Getting Exercise", err)
 	}
 
 	exercise := &database.Exercise{
@@ -104,11 +103,6 @@ func (r *queryResolver) Exercise(ctx context.Context, exerciseID string) (*model
 		return &model.Exercise{}, gqlerror.Errorf("Error Getting Exercise: %s", err.Error())
 	}
 
-	err = r.ACS.CanAccessWorkoutSession(fmt.Sprintf("%d", u.ID), fmt.Sprintf("%d", exercise.WorkoutSessionID))
-	if err != nil {
-		return &model.Exercise{}, gqlerror.Errorf("Error Getting Exercise: %s", err.Error())
-	}
-
 	// invalidate exercise resolver dataloader cache
 	loaders := middleware.GetLoaders(ctx)
 	loaders.SetEntrySliceLoader.Clear(ctx, dataloader.StringKey(fmt.Sprintf("%d", exercise.ID)))
@@ -121,14 +115,14 @@ func (r *queryResolver) Exercise(ctx context.Context, exerciseID string) (*model
 
 // UpdateExercise is the resolver for the updateExercise field.
 func (r *mutationResolver) UpdateExercise(ctx context.Context, exerciseID string, exercise model.UpdateExerciseInput) (*model.Exercise, error) {
-	u, err := middleware.GetUser(ctx)
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.Exercise{}, err
 	}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	err = r.ACS.CanAccessExercise(fmt.Sprintf("%d", u.ID), exerciseID)
 	if err != nil {
-		return &model.Exercise{}, err
+		return &model.Exercise{}, accessError("Error Updating Exercise", err)
 	}
 
 	exerciseIDUint, err := strconv.ParseUint(exerciseID, 10, strconv.IntSize)
@@ -142,11 +136,6 @@ func (r *mutationResolver) UpdateExercise(ctx context.Context, exerciseID string
 		return &model.Exercise{}, gqlerror.Errorf("Error Updating Exercise")
 	}
 
-	err = r.ACS.CanAccessWorkoutSession(fmt.Sprintf("%d", u.ID), fmt.Sprintf("%d", dbExercise.WorkoutSessionID))
-	if err != nil {
-		return &model.Exercise{}, gqlerror.Errorf("Error Updating Exercise: Access Denied")
-	}
-
 	updatedExercise := database.Exercise{
 		Notes: exercise.Notes,
 	}
@@ -167,14 +156,14 @@ func (r *mutationResolver) UpdateExercise(ctx context.Context, exerciseID string
 
 // DeleteExercise is the resolver for the deleteExercise field.
 func (r *mutationResolver) DeleteExercise(ctx context.Context, exerciseID string) (int, error) {
-	u, err := middleware.GetUser(ctx)
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	err = r.ACS.CanAccessExercise(fmt.Sprintf("%d", u.ID), exerciseID)
 	if err != nil {
-		return 0, err
+		return 0, accessError("Error Deleting Exercise", err)
 	}
 
 	exerciseIDUint, err := strconv.ParseUint(exerciseID, 10, strconv.IntSize)
@@ -188,11 +177,6 @@ func (r *mutationResolver) DeleteExercise(ctx context.Context, exerciseID string
 		return 0, gqlerror.Errorf("Error Deleting Exercise")
 	}
 
-	err = r.ACS.CanAccessWorkoutSession(fmt.Sprintf("%d", u.ID), fmt.Sprintf("%d", dbExercise.WorkoutSessionID))
-	if err != nil {
-		return 0, gqlerror.Errorf("Error Deleting Exercise: Access Denied")
-	}
-
 	err = database.DeleteExercise(r.DB, exerciseID)
 	if err != nil {
 		return 0, gqlerror.Errorf("Error Deleting Exercise")
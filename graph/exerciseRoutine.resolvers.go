@@ -2,6 +2,7 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -15,12 +16,7 @@ import (
 
 // AddExerciseRoutine is the resolver for the addExerciseRoutine field.
 func (r *mutationResolver) AddExerciseRoutine(ctx context.Context, workoutRoutineID string, exerciseRoutine model.ExerciseRoutineInput) (*model.ExerciseRoutine, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.ExerciseRoutine{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.ExerciseRoutine{}, err
 	}
@@ -36,7 +32,7 @@ func (r *mutationResolver) AddExerciseRoutine(ctx context.Context, workoutRoutin
 	userId := fmt.Sprintf("%d", u.ID)
 	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
 	if err != nil {
-		return &model.ExerciseRoutine{}, gqlerror.Errorf("Error Adding Exercise Routine: Access Denied")
+		return &model.ExerciseRoutine{}, accessError("Error Adding Exercise Routine", err)
 	}
 
 	workoutRoutineIDUint, err := strconv.ParseUint(workoutRoutineID, 10, strconv.IntSize)
@@ -67,13 +63,8 @@ func (r *mutationResolver) AddExerciseRoutine(ctx context.Context, workoutRoutin
 }
 
 // ExerciseRoutines is the resolver for the exerciseRoutines field.
-func (r *queryResolver) ExerciseRoutines(ctx context.Context, workoutRoutineID string) ([]*model.ExerciseRoutine, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return []*model.ExerciseRoutine{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+func (r *queryResolver) ExerciseRoutines(ctx context.Context, workoutRoutineID string, active *bool) ([]*model.ExerciseRoutine, error) {
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return []*model.ExerciseRoutine{}, err
 	}
@@ -81,10 +72,10 @@ func (r *queryResolver) ExerciseRoutines(ctx context.Context, workoutRoutineID s
 	userId := fmt.Sprintf("%d", u.ID)
 	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
 	if err != nil {
-		return []*model.ExerciseRoutine{}, gqlerror.Errorf("Error Getting Exercise Routine: Access Denied")
+		return []*model.ExerciseRoutine{}, accessError("Error Getting Exercise Routine", err)
 	}
 
-	dbExerciseRoutines, err := database.GetExerciseRoutines(r.DB, workoutRoutineID)
+	dbExerciseRoutines, err := database.GetExerciseRoutines(r.DB, workoutRoutineID, active)
 	if err != nil {
 		return []*model.ExerciseRoutine{}, gqlerror.Errorf("Error Getting Exercise Routine")
 	}
@@ -92,10 +83,11 @@ func (r *queryResolver) ExerciseRoutines(ctx context.Context, workoutRoutineID s
 	exerciseRoutines := make([]*model.ExerciseRoutine, 0)
 	for _, er := range *dbExerciseRoutines {
 		exerciseRoutines = append(exerciseRoutines, &model.ExerciseRoutine{
-			ID:   fmt.Sprintf("%d", er.ID),
-			Name: er.Name,
-			Sets: int(er.Sets),
-			Reps: int(er.Reps),
+			ID:     fmt.Sprintf("%d", er.ID),
+			Active: er.Active,
+			Name:   er.Name,
+			Sets:   int(er.Sets),
+			Reps:   int(er.Reps),
 		})
 	}
 
@@ -104,34 +96,71 @@ func (r *queryResolver) ExerciseRoutines(ctx context.Context, workoutRoutineID s
 
 // DeleteExerciseRoutine is the resolver for the deleteExerciseRoutine field.
 func (r *mutationResolver) DeleteExerciseRoutine(ctx context.Context, exerciseRoutineID string) (int, error) {
-	u, err := middleware.GetUser(ctx)
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	userId := fmt.Sprintf("%d", u.ID)
+	err = r.ACS.CanAccessExerciseRoutine(userId, exerciseRoutineID)
 	if err != nil {
-		return 0, err
+		return 0, accessError("Error Deleting Exercise Routine", err)
 	}
 
-	exerciseRoutine := database.ExerciseRoutine{}
-	err = database.GetExerciseRoutine(r.DB, exerciseRoutineID, &exerciseRoutine)
+	err = database.DeleteExerciseRoutine(r.DB, exerciseRoutineID)
 	if err != nil {
 		return 0, gqlerror.Errorf("Error Deleting Exercise Routine")
 	}
 
+	return 1, nil
+}
+
+// ArchiveExerciseRoutine is the resolver for the archiveExerciseRoutine field.
+func (r *mutationResolver) ArchiveExerciseRoutine(ctx context.Context, exerciseRoutineID string) (*model.ExerciseRoutine, error) {
+	return r.setExerciseRoutineActive(ctx, exerciseRoutineID, false, "Error Archiving Exercise Routine")
+}
+
+// UnarchiveExerciseRoutine is the resolver for the unarchiveExerciseRoutine field.
+func (r *mutationResolver) UnarchiveExerciseRoutine(ctx context.Context, exerciseRoutineID string) (*model.ExerciseRoutine, error) {
+	return r.setExerciseRoutineActive(ctx, exerciseRoutineID, true, "Error Unarchiving Exercise Routine")
+}
+
+// ReorderExerciseRoutines is the resolver for the reorderExerciseRoutines field.
+func (r *mutationResolver) ReorderExerciseRoutines(ctx context.Context, workoutRoutineID string, exerciseRoutineIds []string) ([]*model.ExerciseRoutine, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return []*model.ExerciseRoutine{}, err
+	}
+
 	userId := fmt.Sprintf("%d", u.ID)
-	err = r.ACS.CanAccessWorkoutRoutine(userId, fmt.Sprintf("%d", exerciseRoutine.WorkoutRoutineID))
+	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
 	if err != nil {
-		return 0, gqlerror.Errorf("Error Deleting Exercise Routine: Access Denied")
+		return []*model.ExerciseRoutine{}, accessError("Error Reordering Exercise Routines", err)
 	}
 
-	err = database.DeleteExerciseRoutine(r.DB, exerciseRoutineID)
+	dbExerciseRoutines, err := database.ReorderExerciseRoutines(r.DB, workoutRoutineID, exerciseRoutineIds)
+	if errors.Is(err, database.ErrExerciseRoutinesMismatch) {
+		return []*model.ExerciseRoutine{}, gqlerror.Errorf("Exercise routine ids must be every exercise routine in the workout routine once")
+	}
 	if err != nil {
-		return 0, gqlerror.Errorf("Error Deleting Exercise Routine")
+		return []*model.ExerciseRoutine{}, gqlerror.Errorf("Error Reordering Exercise Routines")
 	}
 
-	return 1, nil
+	loaders := middleware.GetLoaders(ctx)
+	loaders.ExerciseRoutineSliceLoader.Clear(ctx, dataloader.StringKey(workoutRoutineID))
+
+	exerciseRoutines := make([]*model.ExerciseRoutine, 0)
+	for _, er := range dbExerciseRoutines {
+		exerciseRoutines = append(exerciseRoutines, &model.ExerciseRoutine{
+			ID:     utils.UIntToString(er.ID),
+			Active: er.Active,
+			Name:   er.Name,
+			Sets:   int(er.Sets),
+			Reps:   int(er.Reps),
+		})
+	}
+
+	return exerciseRoutines, nil
 }
 
 // ExerciseRoutine is the resolver for the exerciseRoutine field.
@@ -155,3 +184,33 @@ func (r *workoutRoutineResolver) ExerciseRoutines(ctx context.Context, obj *mode
 	}
 	return result.([]*model.ExerciseRoutine), nil
 }
+
+func (r *mutationResolver) setExerciseRoutineActive(ctx context.Context, exerciseRoutineID string, active bool, errMsg string) (*model.ExerciseRoutine, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.ExerciseRoutine{}, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+	err = r.ACS.CanAccessExerciseRoutine(userId, exerciseRoutineID)
+	if err != nil {
+		return &model.ExerciseRoutine{}, accessError(errMsg, err)
+	}
+
+	er, err := database.SetExerciseRoutineActive(r.DB, exerciseRoutineID, active)
+	if err != nil {
+		return &model.ExerciseRoutine{}, gqlerror.Errorf(errMsg)
+	}
+
+	// so the workout routine's exercise routines show the change
+	loaders := middleware.GetLoaders(ctx)
+	loaders.ExerciseRoutineSliceLoader.Clear(ctx, dataloader.StringKey(utils.UIntToString(er.WorkoutRoutineID)))
+
+	return &model.ExerciseRoutine{
+		ID:     utils.UIntToString(er.ID),
+		Active: er.Active,
+		Name:   er.Name,
+		Sets:   int(er.Sets),
+		Reps:   int(er.Reps),
+	}, nil
+}
@@ -7,14 +7,16 @@ type WorkoutRoutine struct {
 	Name             string             `json:"name"`
 	Active           bool               `json:"active"`
 	ExerciseRoutines []*ExerciseRoutine `json:"exerciseRoutines"`
+	Version          int                `json:"version"`
 }
 
 type WorkoutSession struct {
-	ID             string         `json:"id"`
-	Start          time.Time      `json:"start"`
-	End            *time.Time     `json:"end"`
-	WorkoutRoutine WorkoutRoutine `json:"workoutRoutine"`
-	Exercises      []*Exercise    `json:"exercises"`
+	ID                    string         `json:"id"`
+	Start                 time.Time      `json:"start"`
+	End                   *time.Time     `json:"end"`
+	WorkoutRoutine        WorkoutRoutine `json:"workoutRoutine"`
+	WorkoutRoutineVersion int            `json:"workoutRoutineVersion"`
+	Exercises             []*Exercise    `json:"exercises"`
 }
 
 type Exercise struct {
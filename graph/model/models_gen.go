@@ -3,12 +3,65 @@
 package model
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
+type AccountImport struct {
+	WorkoutRoutines  int `json:"workoutRoutines"`
+	ExerciseRoutines int `json:"exerciseRoutines"`
+	WorkoutSessions  int `json:"workoutSessions"`
+	Exercises        int `json:"exercises"`
+	SetEntries       int `json:"setEntries"`
+}
+
+type AdminUser struct {
+	ID                 string     `json:"id"`
+	Name               string     `json:"name"`
+	Email              string     `json:"email"`
+	Role               string     `json:"role"`
+	Verified           bool       `json:"verified"`
+	VerificationSentAt *time.Time `json:"verificationSentAt"`
+	Disabled           bool       `json:"disabled"`
+	LockedUntil        *time.Time `json:"lockedUntil"`
+	TotpEnabled        bool       `json:"totpEnabled"`
+	CreatedAt          time.Time  `json:"createdAt"`
+}
+
+type AdminUserConnection struct {
+	Edges    []*AdminUserEdge `json:"edges"`
+	PageInfo *PageInfo        `json:"pageInfo"`
+}
+
+type AdminUserEdge struct {
+	Node   *AdminUser `json:"node"`
+	Cursor string     `json:"cursor"`
+}
+
+type APIToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
 type AuthResult struct {
-	RefreshToken string `json:"refreshToken"`
-	AccessToken  string `json:"accessToken"`
+	RefreshToken   *string `json:"refreshToken"`
+	AccessToken    *string `json:"accessToken"`
+	ChallengeToken *string `json:"challengeToken"`
+}
+
+type EmailDelivery struct {
+	Status        EmailDeliveryStatus `json:"status"`
+	Attempts      int                 `json:"attempts"`
+	QueuedAt      time.Time           `json:"queuedAt"`
+	SentAt        *time.Time          `json:"sentAt"`
+	NextAttemptAt *time.Time          `json:"nextAttemptAt"`
 }
 
 type ExerciseInput struct {
@@ -25,15 +78,36 @@ type ExerciseRoutine struct {
 	Reps   int    `json:"reps"`
 }
 
+type ExerciseRoutineChange struct {
+	ExerciseRoutineID string                    `json:"exerciseRoutineId"`
+	Type              ExerciseRoutineChangeType `json:"type"`
+	Before            *ExerciseRoutineSnapshot  `json:"before"`
+	After             *ExerciseRoutineSnapshot  `json:"after"`
+}
+
 type ExerciseRoutineInput struct {
 	Name string `json:"name"`
 	Sets int    `json:"sets"`
 	Reps int    `json:"reps"`
 }
 
+type ExerciseRoutineSnapshot struct {
+	ExerciseRoutineID string `json:"exerciseRoutineId"`
+	Name              string `json:"name"`
+	Sets              int    `json:"sets"`
+	Reps              int    `json:"reps"`
+	Position          int    `json:"position"`
+}
+
 type LoginInput struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email      string  `json:"email"`
+	Password   string  `json:"password"`
+	DeviceName *string `json:"deviceName"`
+}
+
+type NewAPIToken struct {
+	Token    string    `json:"token"`
+	APIToken *APIToken `json:"apiToken"`
 }
 
 type PageInfo struct {
@@ -46,8 +120,54 @@ type PasswordResetCredentials struct {
 	ConfirmPassword string `json:"confirmPassword"`
 }
 
+type PersonalBest struct {
+	ExerciseRoutineID string  `json:"exerciseRoutineId"`
+	Name              string  `json:"name"`
+	Weight            float64 `json:"weight"`
+	PreviousWeight    float64 `json:"previousWeight"`
+}
+
+type PublishedRoutine struct {
+	ID               string                   `json:"id"`
+	Name             string                   `json:"name"`
+	Tags             []string                 `json:"tags"`
+	Curated          bool                     `json:"curated"`
+	ExerciseRoutines []*SharedExerciseRoutine `json:"exerciseRoutines"`
+	Adoptions        int                      `json:"adoptions"`
+	RatingCount      int                      `json:"ratingCount"`
+	AverageRating    *float64                 `json:"averageRating"`
+	CreatedAt        time.Time                `json:"createdAt"`
+}
+
+type PublishedRoutineConnection struct {
+	Edges    []*PublishedRoutineEdge `json:"edges"`
+	PageInfo *PageInfo               `json:"pageInfo"`
+}
+
+type PublishedRoutineEdge struct {
+	Node   *PublishedRoutine `json:"node"`
+	Cursor string            `json:"cursor"`
+}
+
 type RefreshSuccess struct {
-	AccessToken string `json:"accessToken"`
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+type RoutineShareCode struct {
+	Code             string    `json:"code"`
+	WorkoutRoutineID string    `json:"workoutRoutineId"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+type Session struct {
+	ID         string    `json:"id"`
+	DeviceName string    `json:"deviceName"`
+	UserAgent  string    `json:"userAgent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	Current    bool      `json:"current"`
 }
 
 type SetEntry struct {
@@ -61,11 +181,29 @@ type SetEntryInput struct {
 	Reps   int     `json:"reps"`
 }
 
+type SharedExerciseRoutine struct {
+	Name string `json:"name"`
+	Sets int    `json:"sets"`
+	Reps int    `json:"reps"`
+}
+
+type SharedRoutine struct {
+	Name             string                   `json:"name"`
+	ExerciseRoutines []*SharedExerciseRoutine `json:"exerciseRoutines"`
+}
+
 type SignupInput struct {
-	Email           string `json:"email"`
-	Name            string `json:"name"`
-	Password        string `json:"password"`
-	ConfirmPassword string `json:"confirmPassword"`
+	Email           string  `json:"email"`
+	Name            string  `json:"name"`
+	Password        string  `json:"password"`
+	ConfirmPassword string  `json:"confirmPassword"`
+	DeviceName      *string `json:"deviceName"`
+	Locale          *string `json:"locale"`
+}
+
+type TotpSetup struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
 }
 
 type UpdateExerciseInput struct {
@@ -96,9 +234,23 @@ type UpdateWorkoutSessionInput struct {
 }
 
 type User struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Email         string     `json:"email"`
+	TotpEnabled   bool       `json:"totpEnabled"`
+	Locale        string     `json:"locale"`
+	Timezone      string     `json:"timezone"`
+	DigestEnabled bool       `json:"digestEnabled"`
+	Sessions      []*Session `json:"sessions"`
+}
+
+type WeeklyDigest struct {
+	WeekStart         time.Time       `json:"weekStart"`
+	WeekEnd           time.Time       `json:"weekEnd"`
+	SessionsCompleted int             `json:"sessionsCompleted"`
+	TotalVolume       float64         `json:"totalVolume"`
+	PersonalBests     []*PersonalBest `json:"personalBests"`
+	NextRoutine       *WorkoutRoutine `json:"nextRoutine"`
 }
 
 type WorkoutRoutineConnection struct {
@@ -106,6 +258,14 @@ type WorkoutRoutineConnection struct {
 	PageInfo *PageInfo             `json:"pageInfo"`
 }
 
+type WorkoutRoutineDiff struct {
+	From       int                      `json:"from"`
+	To         int                      `json:"to"`
+	NameBefore string                   `json:"nameBefore"`
+	NameAfter  string                   `json:"nameAfter"`
+	Changes    []*ExerciseRoutineChange `json:"changes"`
+}
+
 type WorkoutRoutineEdge struct {
 	Node   *WorkoutRoutine `json:"node"`
 	Cursor string          `json:"cursor"`
@@ -116,6 +276,13 @@ type WorkoutRoutineInput struct {
 	ExerciseRoutines []*ExerciseRoutineInput `json:"exerciseRoutines"`
 }
 
+type WorkoutRoutineVersion struct {
+	Version          int                        `json:"version"`
+	Name             string                     `json:"name"`
+	CreatedAt        time.Time                  `json:"createdAt"`
+	ExerciseRoutines []*ExerciseRoutineSnapshot `json:"exerciseRoutines"`
+}
+
 type WorkoutSessionConnection struct {
 	Edges    []*WorkoutSessionEdge `json:"edges"`
 	PageInfo *PageInfo             `json:"pageInfo"`
@@ -132,3 +299,91 @@ type WorkoutSessionInput struct {
 	End              *time.Time       `json:"end"`
 	Exercises        []*ExerciseInput `json:"exercises"`
 }
+
+type EmailDeliveryStatus string
+
+const (
+	EmailDeliveryStatusPending EmailDeliveryStatus = "PENDING"
+	EmailDeliveryStatusSent    EmailDeliveryStatus = "SENT"
+	EmailDeliveryStatusFailed  EmailDeliveryStatus = "FAILED"
+)
+
+var AllEmailDeliveryStatus = []EmailDeliveryStatus{
+	EmailDeliveryStatusPending,
+	EmailDeliveryStatusSent,
+	EmailDeliveryStatusFailed,
+}
+
+func (e EmailDeliveryStatus) IsValid() bool {
+	switch e {
+	case EmailDeliveryStatusPending, EmailDeliveryStatusSent, EmailDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e EmailDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *EmailDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EmailDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EmailDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e EmailDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ExerciseRoutineChangeType string
+
+const (
+	ExerciseRoutineChangeTypeAdded   ExerciseRoutineChangeType = "ADDED"
+	ExerciseRoutineChangeTypeRemoved ExerciseRoutineChangeType = "REMOVED"
+	ExerciseRoutineChangeTypeChanged ExerciseRoutineChangeType = "CHANGED"
+	ExerciseRoutineChangeTypeMoved   ExerciseRoutineChangeType = "MOVED"
+)
+
+var AllExerciseRoutineChangeType = []ExerciseRoutineChangeType{
+	ExerciseRoutineChangeTypeAdded,
+	ExerciseRoutineChangeTypeRemoved,
+	ExerciseRoutineChangeTypeChanged,
+	ExerciseRoutineChangeTypeMoved,
+}
+
+func (e ExerciseRoutineChangeType) IsValid() bool {
+	switch e {
+	case ExerciseRoutineChangeTypeAdded, ExerciseRoutineChangeTypeRemoved, ExerciseRoutineChangeTypeChanged, ExerciseRoutineChangeTypeMoved:
+		return true
+	}
+	return false
+}
+
+func (e ExerciseRoutineChangeType) String() string {
+	return string(e)
+}
+
+func (e *ExerciseRoutineChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExerciseRoutineChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExerciseRoutineChangeType", str)
+	}
+	return nil
+}
+
+func (e ExerciseRoutineChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
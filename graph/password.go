@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/common"
+	"github.com/neilZon/workout-logger-api/config"
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/password"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// checkPassword compares attempt with the user's hash, counting failures
+// towards locking the account so it can't be guessed from a stolen session
+// either. Hashes made with old parameters or algorithms are upgraded.
+func (r *Resolver) checkPassword(dbUser *database.User, attempt string, errMsg string) error {
+	if dbUser.LockedUntil != nil && dbUser.LockedUntil.After(time.Now()) {
+		return &common.RateLimitedError{RetryAfter: time.Until(*dbUser.LockedUntil)}
+	}
+
+	userId := fmt.Sprintf("%d", dbUser.ID)
+	ok, rehash, err := r.Hasher.Verify(dbUser.Password, attempt)
+	// users who signed up through a provider have no hash to match
+	if err != nil && !errors.Is(err, password.ErrUnknownHash) {
+		return gqlerror.Errorf(errMsg)
+	}
+	if !ok {
+		err = database.RecordFailedLogin(r.DB, userId, config.MAX_FAILED_LOGINS, config.LOGIN_LOCKOUT)
+		if err != nil {
+			return gqlerror.Errorf(errMsg)
+		}
+		return gqlerror.Errorf("Incorrect Password")
+	}
+
+	if dbUser.FailedLoginAttempts > 0 || dbUser.LockedUntil != nil {
+		err := database.ResetFailedLogins(r.DB, userId)
+		if err != nil {
+			return gqlerror.Errorf(errMsg)
+		}
+	}
+
+	if rehash {
+		hash, err := r.Hasher.Hash(attempt)
+		if err == nil {
+			err = database.RehashPassword(r.DB, dbUser.ID, dbUser.Password, hash)
+		}
+		// the old hash still works so this can be retried on the next login
+		if err != nil {
+			log.Printf("could not rehash password for user %d: %v", dbUser.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/common"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/ratelimit"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// limits for the unauthenticated auth mutations, counted per caller ip and
+// per email being acted on so neither guessing nor mail bombing scales
+type authRateLimit struct {
+	action  string
+	perIP   ratelimit.Rule
+	perUser ratelimit.Rule
+}
+
+var (
+	loginRateLimit = authRateLimit{
+		action:  "login",
+		perIP:   ratelimit.Rule{Limit: 30, Window: 15 * time.Minute},
+		perUser: ratelimit.Rule{Limit: 10, Window: 15 * time.Minute},
+	}
+	signupRateLimit = authRateLimit{
+		action:  "signup",
+		perIP:   ratelimit.Rule{Limit: 10, Window: time.Hour},
+		perUser: ratelimit.Rule{Limit: 3, Window: time.Hour},
+	}
+	// covers every mutation that sends an email to an address
+	sendEmailRateLimit = authRateLimit{
+		action:  "email",
+		perIP:   ratelimit.Rule{Limit: 10, Window: time.Hour},
+		perUser: ratelimit.Rule{Limit: 3, Window: time.Hour},
+	}
+	// a six digit code can be guessed given enough tries
+	totpRateLimit = authRateLimit{
+		action:  "totp",
+		perIP:   ratelimit.Rule{Limit: 30, Window: 15 * time.Minute},
+		perUser: ratelimit.Rule{Limit: 5, Window: 15 * time.Minute},
+	}
+	// each pin also allows only MAX_VERIFICATION_PIN_ATTEMPTS guesses
+	verifyEmailRateLimit = authRateLimit{
+		action:  "verify",
+		perIP:   ratelimit.Rule{Limit: 30, Window: 15 * time.Minute},
+		perUser: ratelimit.Rule{Limit: 10, Window: 15 * time.Minute},
+	}
+	// each nonce is a row until it is used or expires, there's no email to count against
+	oidcNonceRateLimit = ratelimit.Rule{Limit: 30, Window: 15 * time.Minute}
+)
+
+// checkRateLimit returns a *common.RateLimitedError when the caller or the
+// email has hit the limit for the action. Fails closed if the store is unavailable.
+func (r *Resolver) checkRateLimit(ctx context.Context, limit authRateLimit, email string) error {
+	ip := middleware.GetClient(ctx).IP
+	err := r.Limiter.Allow(ctx, limit.action+":ip:"+ip, limit.perIP)
+	if err == nil {
+		err = r.Limiter.Allow(ctx, limit.action+":email:"+strings.ToLower(email), limit.perUser)
+	}
+
+	var rateLimitedError *common.RateLimitedError
+	if err != nil && !errors.As(err, &rateLimitedError) {
+		return gqlerror.Errorf("Error Processing Request")
+	}
+	return err
+}
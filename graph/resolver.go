@@ -1,7 +1,17 @@
 package graph
 
 import (
+	"time"
+
 	"github.com/neilZon/workout-logger-api/accesscontroller"
+	"github.com/neilZon/workout-logger-api/config"
+	"github.com/neilZon/workout-logger-api/mail"
+	"github.com/neilZon/workout-logger-api/oidc"
+	"github.com/neilZon/workout-logger-api/outbox"
+	"github.com/neilZon/workout-logger-api/password"
+	"github.com/neilZon/workout-logger-api/ratelimit"
+	"github.com/neilZon/workout-logger-api/token"
+	"github.com/neilZon/workout-logger-api/totp"
 	"gorm.io/gorm"
 )
 
@@ -10,6 +20,28 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	DB  *gorm.DB
-	ACS accesscontroller.AccessControllerService
+	DB      *gorm.DB
+	ACS     accesscontroller.AccessControllerService
+	Keys    *token.Keys
+	Limiter *ratelimit.Limiter
+	Mailer  mail.Mailer
+	TOTP    *totp.Authenticator
+	OIDC    *oidc.Verifier
+	// how new passwords are checked and stored
+	PasswordPolicy *password.Policy
+	Hasher         *password.Hasher
+	// how long deleted accounts can be restored, DELETION_GRACE_PERIOD when zero
+	DeletionGracePeriod time.Duration
+}
+
+func (r *Resolver) deletionGracePeriod() time.Duration {
+	if r.DeletionGracePeriod == 0 {
+		return config.DELETION_GRACE_PERIOD
+	}
+	return r.DeletionGracePeriod
+}
+
+// txMailer returns the mailer to send email about a change made in tx with
+func (r *Resolver) txMailer(tx *gorm.DB) mail.Mailer {
+	return outbox.ForTx(r.Mailer, tx)
 }
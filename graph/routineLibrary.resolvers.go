@@ -0,0 +1,213 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/utils"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"gorm.io/gorm"
+)
+
+// PublishRoutine is the resolver for the publishRoutine field.
+func (r *mutationResolver) PublishRoutine(ctx context.Context, workoutRoutineID string, tags []string) (*model.PublishedRoutine, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.PublishedRoutine{}, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
+	if err != nil {
+		return &model.PublishedRoutine{}, accessError("Error Publishing Routine", err)
+	}
+
+	tags, err = normalizeTags(tags)
+	if err != nil {
+		return &model.PublishedRoutine{}, gqlerror.Errorf("Error Publishing Routine: %s", err.Error())
+	}
+
+	// routines admins publish make up the curated part of the library
+	_, err = middleware.GetAdmin(ctx, r.DB)
+	curated := err == nil
+
+	published, err := database.PublishWorkoutRoutine(r.DB, workoutRoutineID, u.ID, tags, curated)
+	if err != nil {
+		return &model.PublishedRoutine{}, gqlerror.Errorf("Error Publishing Routine")
+	}
+
+	return publishedRoutineToModel(published), nil
+}
+
+// UnpublishRoutine is the resolver for the unpublishRoutine field.
+func (r *mutationResolver) UnpublishRoutine(ctx context.Context, publishedRoutineID string) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	userId := u.ID
+	if _, err := middleware.GetAdmin(ctx, r.DB); err == nil {
+		userId = 0
+	}
+
+	unpublished, err := database.UnpublishRoutine(r.DB, publishedRoutineID, userId)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Unpublishing Routine")
+	}
+	if !unpublished {
+		return false, gqlerror.Errorf("Error Unpublishing Routine: Not Found")
+	}
+
+	return true, nil
+}
+
+// RateRoutine is the resolver for the rateRoutine field.
+func (r *mutationResolver) RateRoutine(ctx context.Context, publishedRoutineID string, rating int) (*model.PublishedRoutine, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.PublishedRoutine{}, err
+	}
+
+	if rating < 1 || rating > 5 {
+		return &model.PublishedRoutine{}, gqlerror.Errorf("Error Rating Routine: rating needs to be between 1 to 5")
+	}
+
+	published, err := database.RatePublishedRoutine(r.DB, publishedRoutineID, u.ID, rating)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.PublishedRoutine{}, gqlerror.Errorf("Error Rating Routine: Not Found")
+	}
+	if errors.Is(err, database.ErrRatingOwnRoutine) {
+		return &model.PublishedRoutine{}, gqlerror.Errorf("Error Rating Routine: Can't Rate Your Own Routine")
+	}
+	if err != nil {
+		return &model.PublishedRoutine{}, gqlerror.Errorf("Error Rating Routine")
+	}
+
+	return publishedRoutineToModel(published), nil
+}
+
+// AdoptRoutine is the resolver for the adoptRoutine field.
+func (r *mutationResolver) AdoptRoutine(ctx context.Context, publishedRoutineID string) (*model.WorkoutRoutine, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.WorkoutRoutine{}, err
+	}
+
+	adopted, err := database.AdoptPublishedRoutine(r.DB, publishedRoutineID, u.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Adopting Routine: Not Found")
+	}
+	if err != nil {
+		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Adopting Routine")
+	}
+
+	return &model.WorkoutRoutine{
+		ID:      utils.UIntToString(adopted.ID),
+		Name:    adopted.Name,
+		Active:  adopted.Active,
+		Version: adopted.Version,
+	}, nil
+}
+
+// RoutineLibrary is the resolver for the routineLibrary field.
+func (r *queryResolver) RoutineLibrary(ctx context.Context, search *string, tags []string, curated *bool, first int, after *string) (*model.PublishedRoutineConnection, error) {
+	if first <= 0 || first > 50 {
+		return &model.PublishedRoutineConnection{}, gqlerror.Errorf("Error Getting Routine Library: first needs to be between 1 to 50")
+	}
+
+	query := ""
+	if search != nil {
+		query = strings.TrimSpace(*search)
+	}
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return &model.PublishedRoutineConnection{}, gqlerror.Errorf("Error Getting Routine Library: %s", err.Error())
+	}
+	cursor := ""
+	if after != nil && *after != "" {
+		cursor = *after
+	}
+
+	// fetch one extra to know if there is another page
+	dbRoutines, err := database.SearchRoutineLibrary(r.DB, query, tags, curated, cursor, first+1)
+	if err != nil {
+		return &model.PublishedRoutineConnection{}, gqlerror.Errorf("Error Getting Routine Library")
+	}
+	hasNextPage := len(dbRoutines) > first
+	if hasNextPage {
+		dbRoutines = dbRoutines[:first]
+	}
+
+	edges := make([]*model.PublishedRoutineEdge, 0)
+	for i := range dbRoutines {
+		edges = append(edges, &model.PublishedRoutineEdge{
+			Cursor: utils.UIntToString(dbRoutines[i].ID),
+			Node:   publishedRoutineToModel(&dbRoutines[i]),
+		})
+	}
+
+	return &model.PublishedRoutineConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			HasNextPage: hasNextPage,
+		},
+	}, nil
+}
+
+const maxRoutineTags = 10
+
+// normalizeTags lowercases and trims tags, dropping empty and repeated ones
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > 32 {
+			return nil, fmt.Errorf("tags can't be longer than 32 characters")
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > maxRoutineTags {
+		return nil, fmt.Errorf("routines can't have more than %d tags", maxRoutineTags)
+	}
+	return normalized, nil
+}
+
+func publishedRoutineToModel(p *database.PublishedRoutine) *model.PublishedRoutine {
+	published := &model.PublishedRoutine{
+		ID:               utils.UIntToString(p.ID),
+		Name:             p.Name,
+		Tags:             make([]string, 0),
+		Curated:          p.Curated,
+		ExerciseRoutines: make([]*model.SharedExerciseRoutine, 0),
+		Adoptions:        p.Adoptions,
+		RatingCount:      p.RatingCount,
+		CreatedAt:        p.CreatedAt,
+	}
+	if p.RatingCount > 0 {
+		average := float64(p.RatingTotal) / float64(p.RatingCount)
+		published.AverageRating = &average
+	}
+	for _, tag := range p.Tags {
+		published.Tags = append(published.Tags, tag.Tag)
+	}
+	for _, er := range p.ExerciseRoutines {
+		published.ExerciseRoutines = append(published.ExerciseRoutines, &model.SharedExerciseRoutine{
+			Name: er.Name,
+			Sets: int(er.Sets),
+			Reps: int(er.Reps),
+		})
+	}
+	return published
+}
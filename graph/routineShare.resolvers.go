@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/utils"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"gorm.io/gorm"
+)
+
+// CreateRoutineShareCode is the resolver for the createRoutineShareCode field.
+func (r *mutationResolver) CreateRoutineShareCode(ctx context.Context, workoutRoutineID string) (*model.RoutineShareCode, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.RoutineShareCode{}, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
+	if err != nil {
+		return &model.RoutineShareCode{}, accessError("Error Creating Share Code", err)
+	}
+
+	workoutRoutineIDUint, err := strconv.ParseUint(workoutRoutineID, 10, strconv.IntSize)
+	if err != nil {
+		return &model.RoutineShareCode{}, gqlerror.Errorf("Error Creating Share Code")
+	}
+
+	code, err := utils.GenerateVerificationCode(16)
+	if err != nil {
+		return &model.RoutineShareCode{}, gqlerror.Errorf("Error Creating Share Code")
+	}
+
+	shareCode := &database.RoutineShareCode{
+		Code:             code,
+		WorkoutRoutineID: uint(workoutRoutineIDUint),
+		UserID:           u.ID,
+	}
+	err = database.CreateRoutineShareCode(r.DB, shareCode)
+	if err != nil {
+		return &model.RoutineShareCode{}, gqlerror.Errorf("Error Creating Share Code")
+	}
+
+	return &model.RoutineShareCode{
+		Code:             shareCode.Code,
+		WorkoutRoutineID: utils.UIntToString(shareCode.WorkoutRoutineID),
+		CreatedAt:        shareCode.CreatedAt,
+	}, nil
+}
+
+// RevokeRoutineShareCode is the resolver for the revokeRoutineShareCode field.
+func (r *mutationResolver) RevokeRoutineShareCode(ctx context.Context, code string) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	revoked, err := database.RevokeRoutineShareCode(r.DB, u.ID, code)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Revoking Share Code")
+	}
+	if !revoked {
+		return false, gqlerror.Errorf("Error Revoking Share Code: Not Found")
+	}
+
+	return true, nil
+}
+
+// CloneSharedRoutine is the resolver for the cloneSharedRoutine field.
+func (r *mutationResolver) CloneSharedRoutine(ctx context.Context, code string) (*model.WorkoutRoutine, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.WorkoutRoutine{}, err
+	}
+
+	shared, err := database.GetSharedWorkoutRoutine(r.DB, code)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Cloning Routine: Share Code Invalid Or Revoked")
+	}
+	if err != nil {
+		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Cloning Routine")
+	}
+
+	clone, err := database.CloneWorkoutRoutine(r.DB, shared, u.ID)
+	if err != nil {
+		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Cloning Routine")
+	}
+
+	return &model.WorkoutRoutine{
+		ID:      utils.UIntToString(clone.ID),
+		Name:    clone.Name,
+		Active:  clone.Active,
+		Version: clone.Version,
+	}, nil
+}
+
+// SharedRoutine is the resolver for the sharedRoutine field.
+func (r *queryResolver) SharedRoutine(ctx context.Context, code string) (*model.SharedRoutine, error) {
+	shared, err := database.GetSharedWorkoutRoutine(r.DB, code)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, gqlerror.Errorf("Error Getting Shared Routine")
+	}
+
+	sharedRoutine := &model.SharedRoutine{
+		Name:             shared.Name,
+		ExerciseRoutines: make([]*model.SharedExerciseRoutine, 0),
+	}
+	for _, er := range shared.ExerciseRoutines {
+		sharedRoutine.ExerciseRoutines = append(sharedRoutine.ExerciseRoutines, &model.SharedExerciseRoutine{
+			Name: er.Name,
+			Sets: int(er.Sets),
+			Reps: int(er.Reps),
+		})
+	}
+	return sharedRoutine, nil
+}
@@ -16,6 +16,9 @@ func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResol
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// User returns generated.UserResolver implementation.
+func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
+
 // WorkoutRoutine returns generated.WorkoutRoutineResolver implementation.
 func (r *Resolver) WorkoutRoutine() generated.WorkoutRoutineResolver {
 	return &workoutRoutineResolver{r}
@@ -29,5 +32,6 @@ func (r *Resolver) WorkoutSession() generated.WorkoutSessionResolver {
 type exerciseResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
 type workoutRoutineResolver struct{ *Resolver }
 type workoutSessionResolver struct{ *Resolver }
@@ -17,12 +17,7 @@ import (
 
 // AddSet is the resolver for the addSet field.
 func (r *mutationResolver) AddSet(ctx context.Context, exerciseID string, set model.SetEntryInput) (*model.SetEntry, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.SetEntry{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.SetEntry{}, err
 	}
@@ -35,18 +30,9 @@ func (r *mutationResolver) AddSet(ctx context.Context, exerciseID string, set mo
 	if err != nil {
 		return &model.SetEntry{}, gqlerror.Errorf("Error Adding Set: Invalid Exercise ID")
 	}
-	exercise := database.Exercise{
-		Model: gorm.Model{
-			ID: uint(exerciseIDUint),
-		},
-	}
-	err = database.GetExercise(r.DB, &exercise, false)
+	err = r.ACS.CanAccessExercise(fmt.Sprintf("%d", u.ID), exerciseID)
 	if err != nil {
-		return &model.SetEntry{}, gqlerror.Errorf("Error Adding Set: %s", err)
-	}
-	err = r.ACS.CanAccessWorkoutSession(fmt.Sprintf("%d", u.ID), fmt.Sprintf("%d", exercise.WorkoutSessionID))
-	if err != nil {
-		return &model.SetEntry{}, gqlerror.Errorf("Error Adding Set: Access Denied")
+		return &model.SetEntry{}, accessError("Error Adding Set", err)
 	}
 
 	dbSet := database.SetEntry{
@@ -72,20 +58,21 @@ func (r *mutationResolver) AddSet(ctx context.Context, exerciseID string, set mo
 
 // Sets is the resolver for the sets field.
 func (r *queryResolver) Sets(ctx context.Context, exerciseID string) ([]*model.SetEntry, error) {
-	u, err := middleware.GetUser(ctx)
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return []*model.SetEntry{}, err
 	}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	exerciseIDUint, err := strconv.ParseUint(exerciseID, 10, 64)
 	if err != nil {
-		return []*model.SetEntry{}, err
+		return []*model.SetEntry{}, gqlerror.Errorf("Error Getting Sets: Invalid Exercise ID")
 	}
 
-	exerciseIDUint, err := strconv.ParseUint(exerciseID, 10, 64)
+	err = r.ACS.CanAccessExercise(fmt.Sprintf("%d", u.ID), exerciseID)
 	if err != nil {
-		return []*model.SetEntry{}, gqlerror.Errorf("Error Getting Sets: Invalid Exercise ID")
+		return []*model.SetEntry{}, accessError("Error Getting Sets", err)
 	}
+
 	exercise := database.Exercise{
 		Model: gorm.Model{
 			ID: uint(exerciseIDUint),
@@ -96,11 +83,6 @@ func (r *queryResolver) Sets(ctx context.Context, exerciseID string) ([]*model.S
 		return []*model.SetEntry{}, gqlerror.Errorf("Error Getting Sets")
 	}
 
-	err = r.ACS.CanAccessWorkoutSession(fmt.Sprintf("%d", u.ID), fmt.Sprintf("%d", exercise.WorkoutSessionID))
-	if err != nil {
-		return []*model.SetEntry{}, gqlerror.Errorf("Error Getting Sets: Access Denied")
-	}
-
 	var sets []*model.SetEntry
 	for _, s := range exercise.Sets {
 		sets = append(sets, &model.SetEntry{
@@ -115,12 +97,7 @@ func (r *queryResolver) Sets(ctx context.Context, exerciseID string) ([]*model.S
 
 // UpdateSet is the resolver for the updateSet field.
 func (r *mutationResolver) UpdateSet(ctx context.Context, setID string, set model.UpdateSetEntryInput) (*model.SetEntry, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.SetEntry{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.SetEntry{}, err
 	}
@@ -137,27 +114,17 @@ func (r *mutationResolver) UpdateSet(ctx context.Context, setID string, set mode
 		return &model.SetEntry{}, err
 	}
 
-	var setEntry database.SetEntry
-	err = database.GetSet(r.DB, &setEntry, setID)
+	err = r.ACS.CanAccessSetEntry(fmt.Sprintf("%d", u.ID), setID)
 	if err != nil {
-		return &model.SetEntry{}, gqlerror.Errorf("Error Updating Set")
+		return &model.SetEntry{}, accessError("Error Updating Set", err)
 	}
 
-	exercise := database.Exercise{
-		Model: gorm.Model{
-			ID: setEntry.ExerciseID,
-		},
-	}
-	err = database.GetExercise(r.DB, &exercise, false)
+	var setEntry database.SetEntry
+	err = database.GetSet(r.DB, &setEntry, setID)
 	if err != nil {
 		return &model.SetEntry{}, gqlerror.Errorf("Error Updating Set")
 	}
 
-	err = r.ACS.CanAccessWorkoutSession(fmt.Sprintf("%d", u.ID), fmt.Sprintf("%d", exercise.WorkoutSessionID))
-	if err != nil {
-		return &model.SetEntry{}, gqlerror.Errorf("Error Updating Set: Access Denied")
-	}
-
 	// check optional inputs
 	var reps uint
 	if set.Reps != nil {
@@ -179,7 +146,7 @@ func (r *mutationResolver) UpdateSet(ctx context.Context, setID string, set mode
 
 	// invalidate set entry resolver dataloader cache
 	loaders := middleware.GetLoaders(ctx)
-	loaders.SetEntrySliceLoader.Clear(ctx, dataloader.StringKey(fmt.Sprintf("%d", exercise.ID)))
+	loaders.SetEntrySliceLoader.Clear(ctx, dataloader.StringKey(fmt.Sprintf("%d", setEntry.ExerciseID)))
 
 	return &model.SetEntry{
 		ID:     fmt.Sprintf("%d", updatedSet.ID),
@@ -190,14 +157,14 @@ func (r *mutationResolver) UpdateSet(ctx context.Context, setID string, set mode
 
 // DeleteSet is the resolver for the deleteSet field.
 func (r *mutationResolver) DeleteSet(ctx context.Context, setID string) (int, error) {
-	u, err := middleware.GetUser(ctx)
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	err = r.ACS.CanAccessSetEntry(fmt.Sprintf("%d", u.ID), setID)
 	if err != nil {
-		return 0, err
+		return 0, accessError("Error Deleting Set", err)
 	}
 
 	var setEntry database.SetEntry
@@ -206,21 +173,6 @@ func (r *mutationResolver) DeleteSet(ctx context.Context, setID string) (int, er
 		return 0, gqlerror.Errorf("Error Deleting Set")
 	}
 
-	exercise := database.Exercise{
-		Model: gorm.Model{
-			ID: setEntry.ExerciseID,
-		},
-	}
-	err = database.GetExercise(r.DB, &exercise, false)
-	if err != nil {
-		return 0, gqlerror.Errorf("Error Deleting Set")
-	}
-
-	err = r.ACS.CanAccessWorkoutSession(fmt.Sprintf("%d", u.ID), fmt.Sprintf("%d", exercise.WorkoutSessionID))
-	if err != nil {
-		return 0, gqlerror.Errorf("Error Deleting Set: Access Denied")
-	}
-
 	err = database.DeleteSet(r.DB, setID)
 	if err != nil {
 		return 0, gqlerror.Errorf("Error Deleting Set")
@@ -228,7 +180,7 @@ func (r *mutationResolver) DeleteSet(ctx context.Context, setID string) (int, er
 
 	// invalidate set entry resolver dataloader cache
 	loaders := middleware.GetLoaders(ctx)
-	loaders.SetEntrySliceLoader.Clear(ctx, dataloader.StringKey(fmt.Sprintf("%d", exercise.ID)))
+	loaders.SetEntrySliceLoader.Clear(ctx, dataloader.StringKey(fmt.Sprintf("%d", setEntry.ExerciseID)))
 
 	return 1, nil
 }
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/config"
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/token"
+	"github.com/neilZon/workout-logger-api/utils"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"gorm.io/gorm"
+)
+
+// newRefreshToken builds the database row for a refresh token in the given session
+func newRefreshToken(userId uint, sessionId uint) (*database.RefreshToken, error) {
+	tokenId, err := utils.GenerateVerificationCode(32)
+	if err != nil {
+		return nil, err
+	}
+
+	return &database.RefreshToken{
+		TokenID:   tokenId,
+		ExpiresAt: time.Now().Add(config.REFRESH_TTL * time.Hour),
+		SessionID: sessionId,
+		UserID:    userId,
+	}, nil
+}
+
+// signTokens signs an access token and a refresh token tracked by rt
+func signTokens(keys *token.Keys, c *token.Credentials, rt *database.RefreshToken) (accessToken string, refreshToken string, err error) {
+	accessCredentials := *c
+	accessCredentials.SessionID = rt.SessionID
+
+	refreshCredentials := accessCredentials
+	refreshCredentials.TokenID = rt.TokenID
+
+	refreshToken, err = keys.Refresh.Sign(&refreshCredentials, config.REFRESH_TTL*time.Hour)
+	if err != nil {
+		return "", "", err
+	}
+	accessToken, err = keys.Access.Sign(&accessCredentials, config.ACCESS_TTL*time.Hour)
+	if err != nil {
+		return "", "", err
+	}
+	return accessToken, refreshToken, nil
+}
+
+// issueTokens starts a new session for the calling device and returns
+// a signed access and refresh token pair bound to it
+func issueTokens(ctx context.Context, db *gorm.DB, keys *token.Keys, c *token.Credentials, deviceName *string) (accessToken string, refreshToken string, err error) {
+	client := middleware.GetClient(ctx)
+	session := &database.Session{
+		UserAgent:  truncate(client.UserAgent, 512),
+		IP:         client.IP,
+		LastUsedAt: time.Now(),
+		UserID:     c.ID,
+	}
+	if deviceName != nil {
+		session.DeviceName = truncate(*deviceName, 64)
+	}
+
+	err = database.CreateSession(db, session)
+	if err != nil {
+		return "", "", err
+	}
+
+	rt, err := newRefreshToken(c.ID, session.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = database.CreateRefreshToken(db, rt)
+	if err != nil {
+		return "", "", err
+	}
+
+	return signTokens(keys, c, rt)
+}
+
+// finishLogin returns a challenge for accounts with totp enabled, tokens for
+// a new session otherwise. Errors are ready to return to the client.
+func (r *Resolver) finishLogin(ctx context.Context, dbUser *database.User, deviceName *string) (*model.AuthResult, error) {
+	c := &token.Credentials{
+		ID:    dbUser.ID,
+		Email: dbUser.Email,
+		Name:  dbUser.Name,
+		Role:  dbUser.Role,
+	}
+
+	if dbUser.DisabledAt != nil {
+		return nil, gqlerror.Errorf("Account Disabled")
+	}
+	if dbUser.DeleteAfter != nil {
+		return nil, gqlerror.Errorf("Account Scheduled For Deletion")
+	}
+
+	// tokens wait until the second factor is verified
+	if dbUser.TotpEnabled {
+		challengeId, err := utils.GenerateVerificationCode(32)
+		if err != nil {
+			return nil, gqlerror.Errorf("Error Logging In")
+		}
+		err = database.StartTotpChallenge(r.DB, dbUser.ID, challengeId)
+		if err != nil {
+			return nil, gqlerror.Errorf("Error Logging In")
+		}
+		c.TokenID = challengeId
+
+		challengeToken, err := r.Keys.Challenge.Sign(c, config.CHALLENGE_TTL)
+		if err != nil {
+			return nil, gqlerror.Errorf("Error Logging In")
+		}
+		return &model.AuthResult{
+			ChallengeToken: &challengeToken,
+		}, nil
+	}
+
+	accessToken, refreshToken, err := issueTokens(ctx, r.DB, r.Keys, c, deviceName)
+	if err != nil {
+		return nil, gqlerror.Errorf("Error Logging In")
+	}
+	return &model.AuthResult{
+		RefreshToken: &refreshToken,
+		AccessToken:  &accessToken,
+	}, nil
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
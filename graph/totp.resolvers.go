@@ -0,0 +1,207 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/token"
+	"github.com/neilZon/workout-logger-api/totp"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// EnableTotp is the resolver for the enableTotp field.
+func (r *mutationResolver) EnableTotp(ctx context.Context) (*model.TotpSetup, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.TotpSetup{}, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+
+	dbUser, err := database.GetUserById(r.DB, userId)
+	if err != nil {
+		return &model.TotpSetup{}, gqlerror.Errorf("Error Enabling Totp")
+	}
+	if dbUser.TotpEnabled {
+		return &model.TotpSetup{}, gqlerror.Errorf("Totp Already Enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return &model.TotpSetup{}, gqlerror.Errorf("Error Enabling Totp")
+	}
+
+	err = database.StartTotpSetup(r.DB, userId, secret)
+	if err != nil {
+		return &model.TotpSetup{}, gqlerror.Errorf("Error Enabling Totp")
+	}
+
+	return &model.TotpSetup{
+		Secret: secret,
+		URI:    r.TOTP.URI(secret, dbUser.Email),
+	}, nil
+}
+
+// ConfirmTotp is the resolver for the confirmTotp field.
+func (r *mutationResolver) ConfirmTotp(ctx context.Context, code string) ([]string, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return []string{}, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+
+	dbUser, err := database.GetUserById(r.DB, userId)
+	if err != nil {
+		return []string{}, gqlerror.Errorf("Error Confirming Totp")
+	}
+	if dbUser.TotpEnabled {
+		return []string{}, gqlerror.Errorf("Totp Already Enabled")
+	}
+	if dbUser.TotpSecret == nil {
+		return []string{}, gqlerror.Errorf("Totp Setup Not Started")
+	}
+
+	err = r.checkRateLimit(ctx, totpRateLimit, dbUser.Email)
+	if err != nil {
+		return []string{}, err
+	}
+
+	step, ok := r.TOTP.Validate(*dbUser.TotpSecret, code, dbUser.TotpLastStep)
+	if !ok {
+		return []string{}, gqlerror.Errorf("Invalid Code")
+	}
+
+	recoveryCodes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return []string{}, gqlerror.Errorf("Error Confirming Totp")
+	}
+	hashes := make([]string, len(recoveryCodes))
+	for i, rc := range recoveryCodes {
+		hashes[i] = totp.HashRecoveryCode(rc)
+	}
+
+	err = database.EnableTotp(r.DB, dbUser.ID, step, hashes)
+	if err != nil {
+		return []string{}, gqlerror.Errorf("Error Confirming Totp")
+	}
+
+	// only time the codes are ever shown
+	return recoveryCodes, nil
+}
+
+// DisableTotp is the resolver for the disableTotp field.
+func (r *mutationResolver) DisableTotp(ctx context.Context, code string) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+
+	dbUser, err := database.GetUserById(r.DB, userId)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Disabling Totp")
+	}
+	if !dbUser.TotpEnabled {
+		return false, gqlerror.Errorf("Totp Not Enabled")
+	}
+
+	err = r.checkRateLimit(ctx, totpRateLimit, dbUser.Email)
+	if err != nil {
+		return false, err
+	}
+
+	err = r.verifySecondFactor(dbUser, code)
+	if err != nil {
+		return false, err
+	}
+
+	err = database.DisableTotp(r.DB, dbUser.ID)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Disabling Totp")
+	}
+
+	return true, nil
+}
+
+// VerifyTotpChallenge is the resolver for the verifyTotpChallenge field.
+func (r *mutationResolver) VerifyTotpChallenge(ctx context.Context, challengeToken string, code string, deviceName *string) (*model.AuthResult, error) {
+	claims, err := r.Keys.Challenge.Decode(challengeToken)
+	if err != nil {
+		return &model.AuthResult{}, gqlerror.Errorf("Challenge token invalid")
+	}
+
+	err = r.checkRateLimit(ctx, totpRateLimit, claims.Subject)
+	if err != nil {
+		return &model.AuthResult{}, err
+	}
+
+	dbUser, err := database.GetUserById(r.DB, fmt.Sprintf("%d", claims.ID))
+	if err != nil || !dbUser.TotpEnabled || dbUser.DisabledAt != nil || dbUser.DeleteAfter != nil {
+		return &model.AuthResult{}, gqlerror.Errorf("Challenge token invalid")
+	}
+	// only the latest challenge counts, and only until it is used
+	if dbUser.TotpChallengeID == nil || *dbUser.TotpChallengeID != claims.Id {
+		return &model.AuthResult{}, gqlerror.Errorf("Challenge token invalid")
+	}
+
+	err = r.verifySecondFactor(dbUser, code)
+	if err != nil {
+		return &model.AuthResult{}, err
+	}
+
+	used, err := database.UseTotpChallenge(r.DB, dbUser.ID, claims.Id)
+	if err != nil {
+		return &model.AuthResult{}, gqlerror.Errorf("Error Logging In")
+	}
+	if !used {
+		return &model.AuthResult{}, gqlerror.Errorf("Challenge token invalid")
+	}
+
+	c := &token.Credentials{
+		ID:    dbUser.ID,
+		Email: dbUser.Email,
+		Name:  dbUser.Name,
+		Role:  dbUser.Role,
+	}
+
+	accessToken, refreshToken, err := issueTokens(ctx, r.DB, r.Keys, c, deviceName)
+	if err != nil {
+		return &model.AuthResult{}, gqlerror.Errorf("Error Logging In")
+	}
+
+	return &model.AuthResult{
+		RefreshToken: &refreshToken,
+		AccessToken:  &accessToken,
+	}, nil
+}
+
+// verifySecondFactor accepts either a current totp code or an unused recovery
+// code, each can only be used once
+func (r *Resolver) verifySecondFactor(dbUser *database.User, code string) error {
+	if dbUser.TotpSecret != nil {
+		if step, ok := r.TOTP.Validate(*dbUser.TotpSecret, code, dbUser.TotpLastStep); ok {
+			used, err := database.UseTotpStep(r.DB, dbUser.ID, step)
+			if err != nil {
+				return gqlerror.Errorf("Error Verifying Code")
+			}
+			if !used {
+				return gqlerror.Errorf("Invalid Code")
+			}
+			return nil
+		}
+	}
+
+	used, err := database.UseRecoveryCode(r.DB, dbUser.ID, totp.HashRecoveryCode(code))
+	if err != nil {
+		return gqlerror.Errorf("Error Verifying Code")
+	}
+	if !used {
+		return gqlerror.Errorf("Invalid Code")
+	}
+	return nil
+}
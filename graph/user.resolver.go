@@ -2,41 +2,183 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/neilZon/workout-logger-api/common"
 	"github.com/neilZon/workout-logger-api/database"
 	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/mail"
 	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/utils"
+	"github.com/neilZon/workout-logger-api/validator"
 	"github.com/vektah/gqlparser/v2/gqlerror"
+	"gorm.io/gorm"
 )
 
 // DeleteUser is the resolver for the deleteUser field.
 func (r *mutationResolver) DeleteUser(ctx context.Context) (int, error) {
-	u, err := middleware.GetUser(ctx)
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	dbUser, err := database.GetUserById(r.DB, fmt.Sprintf("%d", u.ID))
 	if err != nil {
-		return 0, err
+		return 0, gqlerror.Errorf("Error Deleting User")
 	}
 
-	err = database.DeleteUser(r.DB, fmt.Sprintf("%d", u.ID))
+	cancelCode, err := utils.GenerateVerificationCode(32)
 	if err != nil {
-		return 0, err
+		return 0, gqlerror.Errorf("Error Deleting User")
 	}
-	return 1, err
+
+	// the account is locked now and only purged once the grace period is over.
+	// the notice is queued with it since its link is the only way to restore it
+	deleteAfter := time.Now().Add(r.deletionGracePeriod())
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := database.ScheduleUserDeletion(tx, dbUser.ID, cancelCode, deleteAfter); err != nil {
+			return err
+		}
+		return mail.SendAccountDeletionNotice(r.txMailer(tx), cancelCode, deleteAfter, dbUser.Email, dbUser.Locale)
+	})
+	if err != nil {
+		return 0, gqlerror.Errorf("Error Deleting User")
+	}
+
+	return 1, nil
 }
 
-// User is the resolver for the user field.
-func (r *queryResolver) User(ctx context.Context) (*model.User, error) {
-	u, err := middleware.GetUser(ctx)
+// RestoreAccount is the resolver for the restoreAccount field.
+func (r *mutationResolver) RestoreAccount(ctx context.Context, code string) (bool, error) {
+	_, err := database.RestoreUser(r.DB, code, time.Now())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, gqlerror.Errorf("Restore link invalid or expired")
+	}
 	if err != nil {
-		return &model.User{}, err
+		return false, gqlerror.Errorf("Error Restoring Account")
+	}
+	return true, nil
+}
+
+// ChangePassword is the resolver for the changePassword field.
+func (r *mutationResolver) ChangePassword(ctx context.Context, currentPassword string, newPassword string) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+
+	dbUser, err := database.GetUserById(r.DB, userId)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Changing Password")
+	}
+
+	err = r.PasswordPolicy.Validate(newPassword, dbUser.Email, dbUser.Name)
+	if err != nil {
+		return false, gqlerror.Errorf(err.Error())
+	}
+
+	err = r.checkPassword(dbUser, currentPassword, "Error Changing Password")
+	if err != nil {
+		return false, err
+	}
+
+	hashedPassword, err := r.Hasher.Hash(newPassword)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Changing Password")
+	}
+
+	// keep the device making the change signed in
+	err = database.UpdatePassword(r.DB, dbUser.ID, hashedPassword, u.SessionID)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Changing Password")
+	}
+
+	return true, nil
+}
+
+// ChangeEmail is the resolver for the changeEmail field.
+func (r *mutationResolver) ChangeEmail(ctx context.Context, newEmail string, password string) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+
+	err = validator.ValidateEmail(newEmail)
+	if err != nil {
+		return false, gqlerror.Errorf("not a valid email")
+	}
+
+	err = r.checkRateLimit(ctx, sendEmailRateLimit, newEmail)
+	if err != nil {
+		return false, err
+	}
+
+	dbUser, err := database.GetUserById(r.DB, userId)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Changing Email")
+	}
+
+	err = r.checkPassword(dbUser, password, "Error Changing Email")
+	if err != nil {
+		return false, err
+	}
+
+	existing, err := database.GetUserByEmail(r.DB, newEmail)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, gqlerror.Errorf("Error Changing Email")
 	}
+	if err == nil && existing.ID != 0 {
+		return false, gqlerror.Errorf("email already exists")
+	}
+
+	code, err := utils.GenerateVerificationCode(64)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Changing Email")
+	}
+
+	// the email only changes once the link sent to the new address is opened
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := database.StartEmailChange(tx, dbUser.ID, newEmail, code); err != nil {
+			return err
+		}
+		return mail.SendEmailChangeLink(r.txMailer(tx), code, newEmail, dbUser.Locale)
+	})
+	if err != nil {
+		return false, gqlerror.Errorf("Error Changing Email")
+	}
+
+	return true, nil
+}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+// ChangeLocale is the resolver for the changeLocale field.
+func (r *mutationResolver) ChangeLocale(ctx context.Context, locale string) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	err = validator.ValidateLocale(locale)
+	if err != nil {
+		return false, gqlerror.Errorf(err.Error())
+	}
+
+	err = database.UpdateUserLocale(r.DB, u.ID, locale)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Changing Locale")
+	}
+
+	return true, nil
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context) (*model.User, error) {
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.User{}, err
 	}
@@ -51,8 +193,67 @@ func (r *queryResolver) User(ctx context.Context) (*model.User, error) {
 	}
 
 	return &model.User{
-		ID:    userId,
-		Email: user.Email,
-		Name:  user.Name,
+		ID:            userId,
+		Email:         user.Email,
+		Name:          user.Name,
+		TotpEnabled:   user.TotpEnabled,
+		Locale:        user.Locale,
+		Timezone:      user.Timezone,
+		DigestEnabled: user.DigestEnabled,
 	}, nil
 }
+
+// Sessions is the resolver for the sessions field.
+func (r *userResolver) Sessions(ctx context.Context, obj *model.User) ([]*model.Session, error) {
+	// sessions show where the user signs in from so api tokens can't list them
+	u, err := middleware.GetUser(ctx)
+	if err != nil {
+		return []*model.Session{}, err
+	}
+	if obj.ID != utils.UIntToString(u.ID) {
+		return []*model.Session{}, &common.ForbiddenError{}
+	}
+
+	dbSessions, err := database.GetActiveSessions(r.DB, obj.ID)
+	if err != nil {
+		return []*model.Session{}, gqlerror.Errorf("Error Getting Sessions")
+	}
+
+	sessions := make([]*model.Session, 0)
+	for _, s := range dbSessions {
+		sessions = append(sessions, &model.Session{
+			ID:         utils.UIntToString(s.ID),
+			DeviceName: s.DeviceName,
+			UserAgent:  s.UserAgent,
+			IP:         s.IP,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			Current:    s.ID == u.SessionID,
+		})
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, sessionID string) (bool, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	session, err := database.GetSession(r.DB, sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && session.UserID != u.ID) {
+		return false, gqlerror.Errorf("Error Revoking Session: Access Denied")
+	}
+	if err != nil {
+		return false, gqlerror.Errorf("Error Revoking Session")
+	}
+
+	err = database.RevokeSession(r.DB, session.ID)
+	if err != nil {
+		return false, gqlerror.Errorf("Error Revoking Session")
+	}
+
+	return true, nil
+}
@@ -0,0 +1,97 @@
+package graph
+
+import (
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/utils"
+)
+
+func exerciseRoutineSnapshot(er *database.ExerciseRoutineVersion, position int) *model.ExerciseRoutineSnapshot {
+	return &model.ExerciseRoutineSnapshot{
+		ExerciseRoutineID: utils.UIntToString(er.ExerciseRoutineID),
+		Name:              er.Name,
+		Sets:              int(er.Sets),
+		Reps:              int(er.Reps),
+		Position:          position,
+	}
+}
+
+func workoutRoutineVersion(v *database.WorkoutRoutineVersion) *model.WorkoutRoutineVersion {
+	version := &model.WorkoutRoutineVersion{
+		Version:          v.Version,
+		Name:             v.Name,
+		CreatedAt:        v.CreatedAt,
+		ExerciseRoutines: make([]*model.ExerciseRoutineSnapshot, 0),
+	}
+	for i := range v.ExerciseRoutines {
+		version.ExerciseRoutines = append(version.ExerciseRoutines, exerciseRoutineSnapshot(&v.ExerciseRoutines[i], i))
+	}
+	return version
+}
+
+// diffWorkoutRoutineVersions lists how each exercise routine changed from one
+// version to another, removed ones first in the order they were in and then
+// the rest in the order they are in now. Exercise routines are only MOVED if
+// their order among the ones in both versions changed, so adding or removing
+// one doesn't move the others.
+func diffWorkoutRoutineVersions(from *database.WorkoutRoutineVersion, to *database.WorkoutRoutineVersion) *model.WorkoutRoutineDiff {
+	diff := &model.WorkoutRoutineDiff{
+		From:       from.Version,
+		To:         to.Version,
+		NameBefore: from.Name,
+		NameAfter:  to.Name,
+		Changes:    make([]*model.ExerciseRoutineChange, 0),
+	}
+
+	after := make(map[uint]int)
+	for i := range to.ExerciseRoutines {
+		after[to.ExerciseRoutines[i].ExerciseRoutineID] = i
+	}
+	before := make(map[uint]int)
+	// order of the kept exercise routines in the from version
+	keptOrder := make(map[uint]int)
+	for i := range from.ExerciseRoutines {
+		er := &from.ExerciseRoutines[i]
+		before[er.ExerciseRoutineID] = i
+		if _, ok := after[er.ExerciseRoutineID]; !ok {
+			diff.Changes = append(diff.Changes, &model.ExerciseRoutineChange{
+				ExerciseRoutineID: utils.UIntToString(er.ExerciseRoutineID),
+				Type:              model.ExerciseRoutineChangeTypeRemoved,
+				Before:            exerciseRoutineSnapshot(er, i),
+			})
+			continue
+		}
+		keptOrder[er.ExerciseRoutineID] = len(keptOrder)
+	}
+
+	kept := 0
+	for i := range to.ExerciseRoutines {
+		er := &to.ExerciseRoutines[i]
+		p, ok := before[er.ExerciseRoutineID]
+		if !ok {
+			diff.Changes = append(diff.Changes, &model.ExerciseRoutineChange{
+				ExerciseRoutineID: utils.UIntToString(er.ExerciseRoutineID),
+				Type:              model.ExerciseRoutineChangeTypeAdded,
+				After:             exerciseRoutineSnapshot(er, i),
+			})
+			continue
+		}
+
+		prev := &from.ExerciseRoutines[p]
+		change := &model.ExerciseRoutineChange{
+			ExerciseRoutineID: utils.UIntToString(er.ExerciseRoutineID),
+			Before:            exerciseRoutineSnapshot(prev, p),
+			After:             exerciseRoutineSnapshot(er, i),
+		}
+		if prev.Name != er.Name || prev.Sets != er.Sets || prev.Reps != er.Reps {
+			change.Type = model.ExerciseRoutineChangeTypeChanged
+			diff.Changes = append(diff.Changes, change)
+		} else if keptOrder[er.ExerciseRoutineID] != kept {
+			change.Type = model.ExerciseRoutineChangeTypeMoved
+			diff.Changes = append(diff.Changes, change)
+		}
+		kept++
+	}
+
+	return diff
+}
@@ -18,12 +18,7 @@ import (
 
 // CreateWorkoutRoutine is the resolver for the createWorkoutRoutine field.
 func (r *mutationResolver) CreateWorkoutRoutine(ctx context.Context, routine model.WorkoutRoutineInput) (*model.WorkoutRoutine, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.WorkoutRoutine{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.WorkoutRoutine{}, err
 	}
@@ -47,8 +42,8 @@ func (r *mutationResolver) CreateWorkoutRoutine(ctx context.Context, routine mod
 	}
 
 	exerciseRoutines := make([]database.ExerciseRoutine, 0)
-	for _, er := range routine.ExerciseRoutines {
-		exerciseRoutines = append(exerciseRoutines, database.ExerciseRoutine{Name: er.Name, Reps: uint(er.Reps), Sets: uint(er.Sets)})
+	for i, er := range routine.ExerciseRoutines {
+		exerciseRoutines = append(exerciseRoutines, database.ExerciseRoutine{Name: er.Name, Reps: uint(er.Reps), Sets: uint(er.Sets), Position: i})
 	}
 
 	wr := &database.WorkoutRoutine{
@@ -57,8 +52,8 @@ func (r *mutationResolver) CreateWorkoutRoutine(ctx context.Context, routine mod
 		UserID:           u.ID,
 	}
 
-	res := database.CreateWorkoutRoutine(r.DB, wr)
-	if res.Error != nil {
+	err = database.CreateWorkoutRoutine(r.DB, wr)
+	if err != nil {
 		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Creating Workout Routine")
 	}
 
@@ -77,17 +72,13 @@ func (r *mutationResolver) CreateWorkoutRoutine(ctx context.Context, routine mod
 		Name:             wr.Name,
 		ExerciseRoutines: []*model.ExerciseRoutine{},
 		Active:           wr.Active,
+		Version:          wr.Version,
 	}, nil
 }
 
 // WorkoutRoutines is the resolver for the workoutRoutines field.
-func (r *queryResolver) WorkoutRoutines(ctx context.Context, limit int, after *string) (*model.WorkoutRoutineConnection, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.WorkoutRoutineConnection{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+func (r *queryResolver) WorkoutRoutines(ctx context.Context, limit int, after *string, active *bool) (*model.WorkoutRoutineConnection, error) {
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.WorkoutRoutineConnection{}, err
 	}
@@ -102,7 +93,7 @@ func (r *queryResolver) WorkoutRoutines(ctx context.Context, limit int, after *s
 		cursor = *after
 	}
 
-	dbWorkoutRoutines, err = database.GetWorkoutRoutines(r.DB, utils.UIntToString(u.ID), cursor, limit)
+	dbWorkoutRoutines, err = database.GetWorkoutRoutines(r.DB, utils.UIntToString(u.ID), active, cursor, limit)
 
 	if err != nil {
 		return &model.WorkoutRoutineConnection{}, gqlerror.Errorf("Error Getting Workout Routine")
@@ -113,9 +104,10 @@ func (r *queryResolver) WorkoutRoutines(ctx context.Context, limit int, after *s
 		edges = append(edges, &model.WorkoutRoutineEdge{
 			Cursor: utils.UIntToString(workoutRoutine.ID),
 			Node: &model.WorkoutRoutine{
-				ID:     utils.UIntToString(workoutRoutine.ID),
-				Name:   workoutRoutine.Name,
-				Active: workoutRoutine.Active,
+				ID:      utils.UIntToString(workoutRoutine.ID),
+				Name:    workoutRoutine.Name,
+				Active:  workoutRoutine.Active,
+				Version: workoutRoutine.Version,
 			},
 		})
 	}
@@ -130,12 +122,7 @@ func (r *queryResolver) WorkoutRoutines(ctx context.Context, limit int, after *s
 
 // WorkoutRoutine is the resolver for the workoutRoutine field.
 func (r *queryResolver) WorkoutRoutine(ctx context.Context, workoutRoutineID string) (*model.WorkoutRoutine, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.WorkoutRoutine{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.WorkoutRoutine{}, err
 	}
@@ -143,7 +130,7 @@ func (r *queryResolver) WorkoutRoutine(ctx context.Context, workoutRoutineID str
 	userId := fmt.Sprintf("%d", u.ID)
 	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
 	if err != nil {
-		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Getting Workout Routine: Access Denied")
+		return &model.WorkoutRoutine{}, accessError("Error Getting Workout Routine", err)
 	}
 
 	workoutRoutine, err := database.GetWorkoutRoutine(r.DB, workoutRoutineID)
@@ -152,20 +139,16 @@ func (r *queryResolver) WorkoutRoutine(ctx context.Context, workoutRoutineID str
 	}
 
 	return &model.WorkoutRoutine{
-		ID:     fmt.Sprintf("%d", workoutRoutine.ID),
-		Name:   workoutRoutine.Name,
-		Active: workoutRoutine.Active,
+		ID:      fmt.Sprintf("%d", workoutRoutine.ID),
+		Name:    workoutRoutine.Name,
+		Active:  workoutRoutine.Active,
+		Version: workoutRoutine.Version,
 	}, nil
 }
 
 // UpdateWorkoutRoutine is the resolver for the updateWorkoutRoutine field.
 func (r *mutationResolver) UpdateWorkoutRoutine(ctx context.Context, workoutRoutine model.UpdateWorkoutRoutineInput) (*model.WorkoutRoutine, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.WorkoutRoutine{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.WorkoutRoutine{}, err
 	}
@@ -186,11 +169,25 @@ func (r *mutationResolver) UpdateWorkoutRoutine(ctx context.Context, workoutRout
 	userId := fmt.Sprintf("%d", u.ID)
 	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutine.ID)
 	if err != nil {
-		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Updating Workout Routine: Access Denied")
+		return &model.WorkoutRoutine{}, accessError("Error Updating Workout Routine", err)
 	}
 
-	var exerciseRoutines []*database.ExerciseRoutine
+	// existing exercise routines must belong to the caller as well
+	var exerciseRoutineIds []string
 	for _, er := range workoutRoutine.ExerciseRoutines {
+		if er.ID != nil {
+			exerciseRoutineIds = append(exerciseRoutineIds, *er.ID)
+		}
+	}
+	if len(exerciseRoutineIds) > 0 {
+		err = r.ACS.CanAccessExerciseRoutines(userId, exerciseRoutineIds)
+		if err != nil {
+			return &model.WorkoutRoutine{}, accessError("Error Updating Workout Routine", err)
+		}
+	}
+
+	var exerciseRoutines []*database.ExerciseRoutine
+	for i, er := range workoutRoutine.ExerciseRoutines {
 		// newly added exercises won't have an ID
 		// nil ID indicates that this exercise should be created, otherwise update
 		// the exercise that has that ID
@@ -213,11 +210,15 @@ func (r *mutationResolver) UpdateWorkoutRoutine(ctx context.Context, workoutRout
 			Name:             er.Name,
 			Sets:             uint(er.Sets),
 			Reps:             uint(er.Reps),
+			Position:         i, // exercise routines are shown in the order they were sent
 			WorkoutRoutineID: uint(workoutRoutineIDUint),
 		})
 	}
 
-	err = database.UpdateWorkoutRoutine(r.DB, workoutRoutine.ID, workoutRoutine.Name, exerciseRoutines)
+	updated, err := database.UpdateWorkoutRoutine(r.DB, workoutRoutine.ID, workoutRoutine.Name, exerciseRoutines)
+	if err == database.ErrExerciseRoutinesMismatch {
+		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Updating Workout Routine: Exercise Routines Must Be The Workout Routine's")
+	}
 	if err != nil {
 		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Updating Workout Routine")
 	}
@@ -227,19 +228,16 @@ func (r *mutationResolver) UpdateWorkoutRoutine(ctx context.Context, workoutRout
 	loaders.ExerciseRoutineSliceLoader.Clear(ctx, dataloader.StringKey(workoutRoutine.ID))
 
 	return &model.WorkoutRoutine{
-		ID:   workoutRoutine.ID,
-		Name: workoutRoutine.Name,
+		ID:      workoutRoutine.ID,
+		Name:    updated.Name,
+		Active:  updated.Active,
+		Version: updated.Version,
 	}, nil
 }
 
 // DeleteWorkoutRoutine is the resolver for the deleteWorkoutRoutine field.
 func (r *mutationResolver) DeleteWorkoutRoutine(ctx context.Context, workoutRoutineID string) (int, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return 0, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -247,7 +245,7 @@ func (r *mutationResolver) DeleteWorkoutRoutine(ctx context.Context, workoutRout
 	userId := fmt.Sprintf("%d", u.ID)
 	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
 	if err != nil {
-		return 0, gqlerror.Errorf("Error Deleting Workout Routine: Access Denied")
+		return 0, accessError("Error Deleting Workout Routine", err)
 	}
 
 	err = database.DeleteWorkoutRoutine(r.DB, workoutRoutineID)
@@ -258,6 +256,16 @@ func (r *mutationResolver) DeleteWorkoutRoutine(ctx context.Context, workoutRout
 	return 1, nil
 }
 
+// ArchiveWorkoutRoutine is the resolver for the archiveWorkoutRoutine field.
+func (r *mutationResolver) ArchiveWorkoutRoutine(ctx context.Context, workoutRoutineID string) (*model.WorkoutRoutine, error) {
+	return r.setWorkoutRoutineActive(ctx, workoutRoutineID, false, "Error Archiving Workout Routine")
+}
+
+// UnarchiveWorkoutRoutine is the resolver for the unarchiveWorkoutRoutine field.
+func (r *mutationResolver) UnarchiveWorkoutRoutine(ctx context.Context, workoutRoutineID string) (*model.WorkoutRoutine, error) {
+	return r.setWorkoutRoutineActive(ctx, workoutRoutineID, true, "Error Unarchiving Workout Routine")
+}
+
 // WorkoutRoutine is the resolver for the workoutRoutine field.
 func (r *workoutSessionResolver) WorkoutRoutine(ctx context.Context, obj *model.WorkoutSession) (*model.WorkoutRoutine, error) {
 	loaders := middleware.GetLoaders(ctx)
@@ -268,3 +276,28 @@ func (r *workoutSessionResolver) WorkoutRoutine(ctx context.Context, obj *model.
 	}
 	return result.(*model.WorkoutRoutine), nil
 }
+
+func (r *mutationResolver) setWorkoutRoutineActive(ctx context.Context, workoutRoutineID string, active bool, errMsg string) (*model.WorkoutRoutine, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.WorkoutRoutine{}, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
+	if err != nil {
+		return &model.WorkoutRoutine{}, accessError(errMsg, err)
+	}
+
+	workoutRoutine, err := database.SetWorkoutRoutineActive(r.DB, workoutRoutineID, active)
+	if err != nil {
+		return &model.WorkoutRoutine{}, gqlerror.Errorf(errMsg)
+	}
+
+	return &model.WorkoutRoutine{
+		ID:      utils.UIntToString(workoutRoutine.ID),
+		Name:    workoutRoutine.Name,
+		Active:  workoutRoutine.Active,
+		Version: workoutRoutine.Version,
+	}, nil
+}
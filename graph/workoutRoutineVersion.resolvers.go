@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"gorm.io/gorm"
+)
+
+// RollbackWorkoutRoutine is the resolver for the rollbackWorkoutRoutine field.
+func (r *mutationResolver) RollbackWorkoutRoutine(ctx context.Context, workoutRoutineID string, version int) (*model.WorkoutRoutine, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.WorkoutRoutine{}, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
+	if err != nil {
+		return &model.WorkoutRoutine{}, accessError("Error Rolling Back Workout Routine", err)
+	}
+
+	workoutRoutine, err := database.RollbackWorkoutRoutine(r.DB, workoutRoutineID, version)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Rolling Back Workout Routine: Version Not Found")
+	}
+	if err != nil {
+		return &model.WorkoutRoutine{}, gqlerror.Errorf("Error Rolling Back Workout Routine")
+	}
+
+	loaders := middleware.GetLoaders(ctx)
+	loaders.ExerciseRoutineSliceLoader.Clear(ctx, dataloader.StringKey(workoutRoutineID))
+
+	return &model.WorkoutRoutine{
+		ID:      workoutRoutineID,
+		Name:    workoutRoutine.Name,
+		Active:  workoutRoutine.Active,
+		Version: workoutRoutine.Version,
+	}, nil
+}
+
+// WorkoutRoutineDiff is the resolver for the workoutRoutineDiff field.
+func (r *queryResolver) WorkoutRoutineDiff(ctx context.Context, workoutRoutineID string, from int, to int) (*model.WorkoutRoutineDiff, error) {
+	u, err := middleware.GetClaims(ctx)
+	if err != nil {
+		return &model.WorkoutRoutineDiff{}, err
+	}
+
+	userId := fmt.Sprintf("%d", u.ID)
+	err = r.ACS.CanAccessWorkoutRoutine(userId, workoutRoutineID)
+	if err != nil {
+		return &model.WorkoutRoutineDiff{}, accessError("Error Getting Workout Routine Diff", err)
+	}
+
+	fromVersion, err := database.GetWorkoutRoutineVersion(r.DB, workoutRoutineID, from)
+	if err != nil {
+		return &model.WorkoutRoutineDiff{}, gqlerror.Errorf("Error Getting Workout Routine Diff")
+	}
+	toVersion, err := database.GetWorkoutRoutineVersion(r.DB, workoutRoutineID, to)
+	if err != nil {
+		return &model.WorkoutRoutineDiff{}, gqlerror.Errorf("Error Getting Workout Routine Diff")
+	}
+
+	return diffWorkoutRoutineVersions(fromVersion, toVersion), nil
+}
+
+// Versions is the resolver for the versions field.
+func (r *workoutRoutineResolver) Versions(ctx context.Context, obj *model.WorkoutRoutine) ([]*model.WorkoutRoutineVersion, error) {
+	dbVersions, err := database.GetWorkoutRoutineVersions(r.DB, obj.ID)
+	if err != nil {
+		return nil, gqlerror.Errorf("Error Getting Workout Routine Versions")
+	}
+
+	versions := make([]*model.WorkoutRoutineVersion, 0)
+	for i := range dbVersions {
+		versions = append(versions, workoutRoutineVersion(&dbVersions[i]))
+	}
+	return versions, nil
+}
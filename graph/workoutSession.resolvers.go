@@ -2,7 +2,6 @@ package graph
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
@@ -16,14 +15,26 @@ import (
 
 // AddWorkoutSession is the resolver for the addWorkoutSession field.
 func (r *mutationResolver) AddWorkoutSession(ctx context.Context, workout model.WorkoutSessionInput) (*model.WorkoutSession, error) {
-	u, err := middleware.GetUser(ctx)
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.WorkoutSession{}, err
 	}
 
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	userId := utils.UIntToString(u.ID)
+	err = r.ACS.CanAccessWorkoutRoutine(userId, workout.WorkoutRoutineID)
 	if err != nil {
-		return &model.WorkoutSession{}, err
+		return &model.WorkoutSession{}, accessError("Error Adding Workout Session", err)
+	}
+
+	var exerciseRoutineIds []string
+	for _, e := range workout.Exercises {
+		exerciseRoutineIds = append(exerciseRoutineIds, e.ExerciseRoutineID)
+	}
+	if len(exerciseRoutineIds) > 0 {
+		err = r.ACS.CanAccessExerciseRoutines(userId, exerciseRoutineIds)
+		if err != nil {
+			return &model.WorkoutSession{}, accessError("Error Adding Workout Session", err)
+		}
 	}
 
 	var dbExercises []database.Exercise
@@ -72,19 +83,15 @@ func (r *mutationResolver) AddWorkoutSession(ctx context.Context, workout model.
 		WorkoutRoutine: model.WorkoutRoutine{
 			ID: workout.WorkoutRoutineID,
 		},
-		Start: ws.Start,
-		End:   ws.End,
+		WorkoutRoutineVersion: ws.WorkoutRoutineVersion,
+		Start:                 ws.Start,
+		End:                   ws.End,
 	}, nil
 }
 
 // UpdateWorkoutSession is the resolver for the updateWorkoutSession field.
 func (r *mutationResolver) UpdateWorkoutSession(ctx context.Context, workoutSessionID string, updateWorkoutSessionInput model.UpdateWorkoutSessionInput) (*model.WorkoutSession, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.WorkoutSession{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.WorkoutSession{}, err
 	}
@@ -92,7 +99,7 @@ func (r *mutationResolver) UpdateWorkoutSession(ctx context.Context, workoutSess
 	userId := utils.UIntToString(u.ID)
 	err = r.ACS.CanAccessWorkoutSession(userId, workoutSessionID)
 	if err != nil {
-		return &model.WorkoutSession{}, gqlerror.Errorf("Error Updating Workout Session: Access Denied")
+		return &model.WorkoutSession{}, accessError("Error Updating Workout Session", err)
 	}
 
 	var start time.Time
@@ -109,20 +116,16 @@ func (r *mutationResolver) UpdateWorkoutSession(ctx context.Context, workoutSess
 	}
 
 	return &model.WorkoutSession{
-		ID:    utils.UIntToString(updatedWorkoutSession.ID),
-		Start: updatedWorkoutSession.Start,
-		End:   updatedWorkoutSession.End,
+		ID:                    utils.UIntToString(updatedWorkoutSession.ID),
+		WorkoutRoutineVersion: updatedWorkoutSession.WorkoutRoutineVersion,
+		Start:                 updatedWorkoutSession.Start,
+		End:                   updatedWorkoutSession.End,
 	}, nil
 }
 
 // DeleteWorkoutSession is the resolver for the deleteWorkoutSession field.
 func (r *mutationResolver) DeleteWorkoutSession(ctx context.Context, workoutSessionID string) (int, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return 0, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -130,7 +133,7 @@ func (r *mutationResolver) DeleteWorkoutSession(ctx context.Context, workoutSess
 	userId := utils.UIntToString(u.ID)
 	err = r.ACS.CanAccessWorkoutSession(userId, workoutSessionID)
 	if err != nil {
-		return 0, gqlerror.Errorf("Error Deleting Workout Session: Access Denied")
+		return 0, accessError("Error Deleting Workout Session", err)
 	}
 
 	err = database.DeleteWorkoutSession(r.DB, workoutSessionID)
@@ -143,12 +146,7 @@ func (r *mutationResolver) DeleteWorkoutSession(ctx context.Context, workoutSess
 
 // WorkoutSessions is the resolver for the workoutSessions field.
 func (r *queryResolver) WorkoutSessions(ctx context.Context, limit int, after *string) (*model.WorkoutSessionConnection, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.WorkoutSessionConnection{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.WorkoutSessionConnection{}, err
 	}
@@ -177,8 +175,9 @@ func (r *queryResolver) WorkoutSessions(ctx context.Context, limit int, after *s
 				WorkoutRoutine: model.WorkoutRoutine{
 					ID: utils.UIntToString(workoutSession.WorkoutRoutineID),
 				},
-				Start: workoutSession.Start,
-				End:   workoutSession.End,
+				WorkoutRoutineVersion: workoutSession.WorkoutRoutineVersion,
+				Start:                 workoutSession.Start,
+				End:                   workoutSession.End,
 			},
 		})
 	}
@@ -193,12 +192,7 @@ func (r *queryResolver) WorkoutSessions(ctx context.Context, limit int, after *s
 
 // WorkoutSession is the resolver for the workoutSession field.
 func (r *queryResolver) WorkoutSession(ctx context.Context, workoutSessionID string) (*model.WorkoutSession, error) {
-	u, err := middleware.GetUser(ctx)
-	if err != nil {
-		return &model.WorkoutSession{}, err
-	}
-
-	err = middleware.VerifyUser(r.DB, fmt.Sprintf("%d", u.ID))
+	u, err := middleware.GetClaims(ctx)
 	if err != nil {
 		return &model.WorkoutSession{}, err
 	}
@@ -214,7 +208,8 @@ func (r *queryResolver) WorkoutSession(ctx context.Context, workoutSessionID str
 		WorkoutRoutine: model.WorkoutRoutine{
 			ID: utils.UIntToString(workoutSession.WorkoutRoutineID),
 		},
-		Start: workoutSession.Start,
-		End:   workoutSession.End,
+		WorkoutRoutineVersion: workoutSession.WorkoutRoutineVersion,
+		Start:                 workoutSession.Start,
+		End:                   workoutSession.End,
 	}, nil
 }
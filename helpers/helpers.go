@@ -3,6 +3,9 @@ package helpers
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"regexp"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql"
@@ -11,19 +14,30 @@ import (
 	"github.com/graph-gophers/dataloader"
 	"github.com/neilZon/workout-logger-api/accesscontroller"
 	"github.com/neilZon/workout-logger-api/common"
+	"github.com/neilZon/workout-logger-api/config"
 	"github.com/neilZon/workout-logger-api/graph"
 	"github.com/neilZon/workout-logger-api/graph/generated"
 	"github.com/neilZon/workout-logger-api/loader"
+	"github.com/neilZon/workout-logger-api/mail"
 	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/oidc"
+	"github.com/neilZon/workout-logger-api/password"
+	"github.com/neilZon/workout-logger-api/ratelimit"
 	"github.com/neilZon/workout-logger-api/reader"
 	"github.com/neilZon/workout-logger-api/token"
+	"github.com/neilZon/workout-logger-api/totp"
+	"github.com/neilZon/workout-logger-api/utils"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
-const WorkoutRoutineAccessQuery = `SELECT * FROM "workout_routines" WHERE id = $1 AND "workout_routines"."deleted_at" IS NULL ORDER BY "workout_routines"."id" LIMIT 1`
-const WorkoutSessionAccessQuery = `SELECT * FROM "workout_sessions" WHERE id = $1 AND "workout_sessions"."deleted_at" IS NULL ORDER BY "workout_sessions"."id" LIMIT 1`
+// access checks for a single id, rows have id and user_id columns
+const WorkoutRoutineAccessQuery = `SELECT workout_routines.id, workout_routines.user_id FROM "workout_routines" WHERE workout_routines.deleted_at IS NULL AND workout_routines.id IN ($1)`
+const WorkoutSessionAccessQuery = `SELECT workout_sessions.id, workout_sessions.user_id FROM "workout_sessions" WHERE workout_sessions.deleted_at IS NULL AND workout_sessions.id IN ($1)`
+const ExerciseRoutineAccessQuery = `SELECT exercise_routines.id, workout_routines.user_id FROM "exercise_routines" JOIN workout_routines ON workout_routines.id = exercise_routines.workout_routine_id AND workout_routines.deleted_at IS NULL WHERE exercise_routines.deleted_at IS NULL AND exercise_routines.id IN ($1)`
+const ExerciseAccessQuery = `SELECT exercises.id, workout_sessions.user_id FROM "exercises" JOIN workout_sessions ON workout_sessions.id = exercises.workout_session_id AND workout_sessions.deleted_at IS NULL WHERE exercises.deleted_at IS NULL AND exercises.id IN ($1)`
+const SetEntryAccessQuery = `SELECT set_entries.id, workout_sessions.user_id FROM "set_entries" JOIN exercises ON exercises.id = set_entries.exercise_id AND exercises.deleted_at IS NULL JOIN workout_sessions ON workout_sessions.id = exercises.workout_session_id AND workout_sessions.deleted_at IS NULL WHERE set_entries.deleted_at IS NULL AND set_entries.id IN ($1)`
 
 func SetupMockDB() (sqlmock.Sqlmock, *gorm.DB) {
 	mockDb, mock, err := sqlmock.New()
@@ -38,11 +52,11 @@ func SetupMockDB() (sqlmock.Sqlmock, *gorm.DB) {
 	return mock, gormDB
 }
 
-func NewGqlServer(gormDB *gorm.DB, acs accesscontroller.AccessControllerService) *handler.Server {
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{
-		DB:  gormDB,
-		ACS: acs,
-	}}))
+func NewGqlServer(resolver *graph.Resolver) *handler.Server {
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  resolver,
+		Directives: graph.NewDirectives(resolver.DB),
+	}))
 
 	srv.SetErrorPresenter(func(ctx context.Context, e error) *gqlerror.Error {
 		err := graphql.DefaultErrorPresenter(ctx, e)
@@ -53,13 +67,57 @@ func NewGqlServer(gormDB *gorm.DB, acs accesscontroller.AccessControllerService)
 				"code": "UNAUTHORIZED",
 			}
 		}
+		var forbiddenError *common.ForbiddenError
+		if errors.As(e, &forbiddenError) {
+			err.Extensions = map[string]interface{}{
+				"code": "FORBIDDEN",
+			}
+		}
+		// tell the client how long to back off for
+		var rateLimitedError *common.RateLimitedError
+		if errors.As(e, &rateLimitedError) {
+			err.Extensions = map[string]interface{}{
+				"code":       "RATE_LIMITED",
+				"retryAfter": int(math.Ceil(rateLimitedError.RetryAfter.Seconds())),
+			}
+		}
 		return err
 	})
 	return srv
 }
 
+// NewGqlClient creates a client for tests, tokens are signed with the keys configured in the env
 func NewGqlClient(gormDB *gorm.DB, acs accesscontroller.AccessControllerService) *client.Client {
-	srv := NewGqlServer(gormDB, acs)
+	return NewGqlClientWithMailer(gormDB, acs, mail.NewRecorder())
+}
+
+// NewGqlClientWithMailer is NewGqlClient with the mailer emails are sent
+// through, pass a mail.Recorder to check what was sent
+func NewGqlClientWithMailer(gormDB *gorm.DB, acs accesscontroller.AccessControllerService, mailer mail.Mailer) *client.Client {
+	keys, err := token.LoadKeys()
+	if err != nil {
+		panic(err)
+	}
+	oidcProviders, err := oidc.LoadProviders()
+	if err != nil {
+		panic(err)
+	}
+	passwordPolicy, err := password.LoadPolicy()
+	if err != nil {
+		panic(err)
+	}
+	srv := NewGqlServer(&graph.Resolver{
+		DB:      gormDB,
+		ACS:     acs,
+		Keys:    keys,
+		Limiter: ratelimit.NewLimiter(ratelimit.NewMemoryStore()),
+		Mailer:  mailer,
+		TOTP:    totp.New(config.TOTP_ISSUER),
+		OIDC:    oidc.NewVerifier(oidcProviders...),
+
+		PasswordPolicy: passwordPolicy,
+		Hasher:         password.DefaultHasher(),
+	})
 	return client.New(srv)
 }
 
@@ -90,6 +148,50 @@ func AddContext(u *token.Claims, l *loader.Loaders) client.Option {
 	return func(bd *client.Request) {
 		ctx := context.WithValue(bd.HTTP.Context(), middleware.UserCtxKey, u)
 		ctx = context.WithValue(ctx, middleware.LoadersKey, l)
+		ctx = middleware.WithVerifiedCache(ctx)
 		bd.HTTP = bd.HTTP.WithContext(ctx)
 	}
 }
+
+// ExpectVerified expects the lookup @verified does for the signed in user
+// and has it find a verified account
+func ExpectVerified(mock sqlmock.Sqlmock, u *token.Claims) {
+	userRow := sqlmock.
+		NewRows([]string{"id", "name", "email", "verified"}).
+		AddRow(u.ID, u.Name, u.Subject, true)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+		WithArgs(utils.UIntToString(u.ID)).
+		WillReturnRows(userRow)
+}
+
+// ExpectWorkoutRoutineChangeStart expects a workout routine at version to be
+// locked for a change with a snapshot of that version already saved
+func ExpectWorkoutRoutineChangeStart(mock sqlmock.Sqlmock, workoutRoutineId uint, name string, version int) {
+	mock.ExpectBegin()
+	workoutRoutineRow := sqlmock.
+		NewRows([]string{"id", "name", "active", "version"}).
+		AddRow(workoutRoutineId, name, true, version)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "workout_routines" WHERE id = $1 AND "workout_routines"."deleted_at" IS NULL ORDER BY "workout_routines"."id" LIMIT 1 FOR UPDATE`)).
+		WithArgs(fmt.Sprintf("%d", workoutRoutineId)).
+		WillReturnRows(workoutRoutineRow)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "workout_routine_versions" WHERE (workout_routine_id = $1 AND version = $2) AND "workout_routine_versions"."deleted_at" IS NULL`)).
+		WithArgs(workoutRoutineId, version).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+}
+
+// ExpectWorkoutRoutineChangeSave expects a changed workout routine to be saved
+// as version with exerciseRoutineRows as its exercise routines
+func ExpectWorkoutRoutineChangeSave(mock sqlmock.Sqlmock, workoutRoutineId uint, name string, version int, exerciseRoutineRows *sqlmock.Rows) {
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "workout_routines" SET "name"=$1,"version"=$2,"updated_at"=$3 WHERE "workout_routines"."deleted_at" IS NULL AND "id" = $4`)).
+		WithArgs(name, version, sqlmock.AnyArg(), workoutRoutineId).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exercise_routines" WHERE workout_routine_id = $1 AND "exercise_routines"."deleted_at" IS NULL ORDER BY position, id`)).
+		WithArgs(fmt.Sprintf("%d", workoutRoutineId)).
+		WillReturnRows(exerciseRoutineRows)
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routine_versions"`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, workoutRoutineId, version, name).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercise_routine_versions"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+}
@@ -0,0 +1,61 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// TestIssuer stands in for an openid connect provider, serving discovery
+// and a JWKS with a single RSA key
+type TestIssuer struct {
+	*httptest.Server
+	Key *rsa.PrivateKey
+	Kid string
+}
+
+func NewTestIssuer() *TestIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	iss := &TestIssuer{Key: key, Kid: "test-key"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   iss.URL,
+			"jwks_uri": iss.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": iss.Kid,
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(iss.Key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(iss.Key.E)).Bytes()),
+			}},
+		})
+	})
+	iss.Server = httptest.NewServer(mux)
+	return iss
+}
+
+// Sign signs an ID token with the issuer's key
+func (iss *TestIssuer) Sign(claims jwt.MapClaims) string {
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	t.Header["kid"] = iss.Kid
+	s, err := t.SignedString(iss.Key)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
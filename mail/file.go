@@ -0,0 +1,44 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// FileMailer writes messages to a maildir instead of sending them so they can
+// be read with a mail client during development
+type FileMailer struct {
+	Dir  string
+	From string
+}
+
+var deliveries uint64
+
+// NewFileMailer creates the maildir at dir if it doesn't exist yet
+func NewFileMailer(dir string, from string) (*FileMailer, error) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0700); err != nil {
+			return nil, err
+		}
+	}
+	return &FileMailer{Dir: dir, From: from}, nil
+}
+
+// Send writes to tmp first and then moves the message into new so readers
+// never see a partially written message
+func (f *FileMailer) Send(msg *Message) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	name := fmt.Sprintf("%d.%d_%d.%s", time.Now().UnixNano(), os.Getpid(), atomic.AddUint64(&deliveries, 1), hostname)
+
+	tmp := filepath.Join(f.Dir, "tmp", name)
+	if err := os.WriteFile(tmp, msg.bytes(f.From), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(f.Dir, "new", name))
+}
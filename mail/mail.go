@@ -1,121 +1,154 @@
 package mail
 
 import (
-	"bytes"
-	"errors"
 	"fmt"
-	"net/smtp"
+	"net/url"
 	"os"
-	"path/filepath"
-	"text/template"
+	"time"
 
 	"github.com/neilZon/workout-logger-api/config"
 )
 
-type loginAuth struct {
-	username, password string
-}
+// what each email is for so queued emails can be told apart, also the name
+// of its templates
+const (
+	KindVerification    = "verification"
+	KindPasswordReset   = "password_reset"
+	KindLoginLink       = "login_link"
+	KindEmailChange     = "email_change"
+	KindEmailChanged    = "email_changed"
+	KindAccountDeletion = "account_deletion"
+	KindWeeklyDigest    = "weekly_digest"
+)
 
-func LoginAuth(username, password string) smtp.Auth {
-	return &loginAuth{username, password}
+// sendEmail renders a kind of email in the recipient's locale and sends it
+func sendEmail(m Mailer, kind string, locale string, to []string, data interface{}) error {
+	msg, err := render(kind, locale, data)
+	if err != nil {
+		return err
+	}
+	msg.To = to
+	return m.Send(msg)
 }
 
-func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
-	return "LOGIN", []byte{}, nil
-}
+// SendVerificationCode sends a link to verify an email along with a pin
+// that can be typed into the app instead
+func SendVerificationCode(m Mailer, code string, pin string, recipient string, locale string) error {
+	host := os.Getenv(config.HOST)
 
-func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
-	if more {
-		switch string(fromServer) {
-		case "Username:":
-			return []byte(a.username), nil
-		case "Password:":
-			return []byte(a.password), nil
-		default:
-			return nil, errors.New("Unkown fromServer")
-		}
+	templateData := struct {
+		Link string
+		Pin  string
+	}{
+		Link: fmt.Sprintf("%s/verify?code=%s", host, code),
+		Pin:  pin,
 	}
-	return nil, nil
-}
 
-func sendEmail(to []string, subject_line string, body string) error {
-	from := os.Getenv(config.EMAIL)
-	pass := os.Getenv(config.APP_PASSWORD)
-	auth := LoginAuth(from, pass)
+	return sendEmail(m, KindVerification, locale, []string{recipient}, templateData)
+}
 
-	mime := "MIME-version: 1.0;\nContent-Type: text/html; charset=\"UTF-8\";\n\n"
-	subject := "Subject: " + subject_line + "!\n"
-	msg := []byte(subject + mime + "\n" + body)
-	addr := "smtp.gmail.com:587"
+func SendResetLink(m Mailer, code string, recipient string, locale string) error {
+	host := os.Getenv(config.HOST)
 
-	if err := smtp.SendMail(addr, auth, from, to, msg); err != nil {
-		return err
+	templateData := struct {
+		Link string
+	}{
+		Link: fmt.Sprintf("%s/static/password-redirect.html?code=%s", host, code),
 	}
-	return nil
-}
 
-func parseTemplate(templateFileName string, data interface{}) (string, error) {
-	t, err := template.ParseFiles(templateFileName)
-	if err != nil {
-		return "", err
-	}
-	buf := new(bytes.Buffer)
-	if err = t.Execute(buf, data); err != nil {
-		return "", err
-	}
-	return buf.String(), nil
+	return sendEmail(m, KindPasswordReset, locale, []string{recipient}, templateData)
 }
 
-func SendVerificationCode(code string, recipient string) error {
+func SendLoginLink(m Mailer, code string, recipient string, locale string) error {
 	host := os.Getenv(config.HOST)
 
 	templateData := struct {
 		Link string
 	}{
-		Link: fmt.Sprintf("%s/verify?code=%s", host, code),
+		Link: fmt.Sprintf("%s/static/login-redirect.html?code=%s", host, url.QueryEscape(code)),
 	}
 
-	abs, err := filepath.Abs("./mail/email-verification-template.html")
-	if err != nil {
-		return err
-	}
+	return sendEmail(m, KindLoginLink, locale, []string{recipient}, templateData)
+}
 
-	body, err := parseTemplate(abs, templateData)
-	if err != nil {
-		return err
+func SendEmailChangeLink(m Mailer, code string, recipient string, locale string) error {
+	host := os.Getenv(config.HOST)
+
+	templateData := struct {
+		Link string
+	}{
+		Link: fmt.Sprintf("%s/confirm-email?code=%s", host, url.QueryEscape(code)),
 	}
 
-	err = sendEmail([]string{recipient}, "Email Verification", body)
-	if err != nil {
-		return err
+	return sendEmail(m, KindEmailChange, locale, []string{recipient}, templateData)
+}
+
+// SendEmailChangedNotice lets the previous address know the account moved
+// in case the change wasn't made by them
+func SendEmailChangedNotice(m Mailer, newEmail string, recipient string, locale string) error {
+	templateData := struct {
+		NewEmail string
+	}{
+		NewEmail: newEmail,
 	}
 
-	return nil
+	return sendEmail(m, KindEmailChanged, locale, []string{recipient}, templateData)
 }
 
-func SendResetLink(code string, recipient string) error {
+// SendAccountDeletionNotice tells a user when their account will be purged
+// and links to the app to cancel the deletion until then
+func SendAccountDeletionNotice(m Mailer, code string, deleteAfter time.Time, recipient string, locale string) error {
 	host := os.Getenv(config.HOST)
 
 	templateData := struct {
-		Link string
+		Link        string
+		DeleteAfter time.Time
 	}{
-		Link: fmt.Sprintf("%s/static/password-redirect.html?code=%s", host, code),
+		Link:        fmt.Sprintf("%s/static/restore-account-redirect.html?code=%s", host, url.QueryEscape(code)),
+		DeleteAfter: deleteAfter,
 	}
 
-	abs, err := filepath.Abs("./mail/reset-password-template.html")
-	if err != nil {
-		return err
-	}
+	return sendEmail(m, KindAccountDeletion, locale, []string{recipient}, templateData)
+}
 
-	body, err := parseTemplate(abs, templateData)
-	if err != nil {
-		return err
+// WeeklyDigest is what the weekly digest email shows of a user's training
+type WeeklyDigest struct {
+	Name string
+	// first and last day of the week
+	WeekStart     time.Time
+	WeekEnd       time.Time
+	Sessions      int
+	Volume        float64
+	PersonalBests []DigestPersonalBest
+	// empty when the user has no active routines
+	NextRoutine string
+}
+
+type DigestPersonalBest struct {
+	Exercise       string
+	Weight         float32
+	PreviousWeight float32
+}
+
+// SendWeeklyDigest sends a digest with a link to unsubscribe that works without logging in
+func SendWeeklyDigest(m Mailer, digest *WeeklyDigest, unsubscribeCode string, recipient string, locale string) error {
+	host := os.Getenv(config.HOST)
+	link := fmt.Sprintf("%s/unsubscribe?code=%s", host, url.QueryEscape(unsubscribeCode))
+
+	templateData := struct {
+		*WeeklyDigest
+		UnsubscribeLink string
+	}{
+		WeeklyDigest:    digest,
+		UnsubscribeLink: link,
 	}
 
-	err = sendEmail([]string{recipient}, "Til Failure Password Reset", body)
+	msg, err := render(KindWeeklyDigest, locale, templateData)
 	if err != nil {
 		return err
 	}
-
-	return nil
+	msg.To = []string{recipient}
+	// mail clients post here from their own unsubscribe button
+	msg.ListUnsubscribe = link
+	return m.Send(msg)
 }
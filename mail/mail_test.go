@@ -0,0 +1,123 @@
+package mail
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplates(t *testing.T) {
+	t.Run("Every locale has every kind of email", func(t *testing.T) {
+		kinds := []string{KindVerification, KindPasswordReset, KindLoginLink, KindEmailChange, KindEmailChanged, KindAccountDeletion, KindWeeklyDigest}
+		for locale, lt := range locales {
+			for _, kind := range kinds {
+				assert.NotNil(t, lt.html[kind], "%s/%s.html", locale, kind)
+				assert.NotNil(t, lt.text[kind], "%s/%s.txt", locale, kind)
+			}
+		}
+	})
+
+	t.Run("Sends in the recipient's locale", func(t *testing.T) {
+		r := NewRecorder()
+		deleteAfter := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+		require.Nil(t, SendAccountDeletionNotice(r, "code", deleteAfter, "lifter@test.com", "es-MX"))
+
+		msg := r.Messages()[0]
+		assert.Equal(t, KindAccountDeletion, msg.Kind)
+		assert.Equal(t, "¡Tu cuenta se eliminará!", msg.Subject)
+		assert.Contains(t, msg.HTML, "1 de junio de 2023")
+		assert.Contains(t, msg.Text, "1 de junio de 2023")
+	})
+
+	t.Run("Falls back to english", func(t *testing.T) {
+		r := NewRecorder()
+		require.Nil(t, SendVerificationCode(r, "code", "042917", "lifter@test.com", "fr_CA"))
+		require.Nil(t, SendVerificationCode(r, "code", "042917", "lifter@test.com", ""))
+
+		for _, msg := range r.Messages() {
+			assert.Equal(t, "Email Verification!", msg.Subject)
+			assert.Contains(t, msg.Text, "/verify?code=code")
+			assert.Contains(t, msg.Text, "042917")
+			assert.Contains(t, msg.HTML, "042917")
+		}
+	})
+
+	t.Run("Escapes what users typed in html", func(t *testing.T) {
+		r := NewRecorder()
+		require.Nil(t, SendEmailChangedNotice(r, "<b>x</b>@test.com", "lifter@test.com", "en"))
+
+		msg := r.Messages()[0]
+		assert.Contains(t, msg.HTML, "&lt;b&gt;x&lt;/b&gt;@test.com")
+		assert.Contains(t, msg.Text, "<b>x</b>@test.com")
+	})
+
+	t.Run("Messages with text are multipart alternative", func(t *testing.T) {
+		msg := &Message{
+			To:      []string{"lifter@test.com"},
+			Subject: "¡Hola!",
+			HTML:    "<p>hola</p>",
+			Text:    "hola",
+		}
+
+		parsed, err := mail.ReadMessage(strings.NewReader(string(msg.bytes("noreply@test.com"))))
+		require.Nil(t, err)
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		require.Nil(t, err)
+		assert.Equal(t, "¡Hola!", subject)
+
+		mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+		require.Nil(t, err)
+		assert.Equal(t, "multipart/alternative", mediaType)
+
+		parts := multipart.NewReader(parsed.Body, params["boundary"])
+		var types, bodies []string
+		for {
+			p, err := parts.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.Nil(t, err)
+			body, err := io.ReadAll(p)
+			require.Nil(t, err)
+			types = append(types, p.Header.Get("Content-Type"))
+			bodies = append(bodies, string(body))
+		}
+		assert.Equal(t, []string{`text/plain; charset="UTF-8"`, `text/html; charset="UTF-8"`}, types)
+		assert.Equal(t, []string{"hola", "<p>hola</p>"}, bodies)
+	})
+	t.Run("Digests can be unsubscribed from in one click", func(t *testing.T) {
+		r := NewRecorder()
+		digest := &WeeklyDigest{
+			Name:      "lifter",
+			WeekStart: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			WeekEnd:   time.Date(2023, 5, 7, 0, 0, 0, 0, time.UTC),
+		}
+		require.Nil(t, SendWeeklyDigest(r, digest, "unsub code", "lifter@test.com", "en"))
+
+		msg := r.Messages()[0]
+		assert.True(t, strings.HasSuffix(msg.ListUnsubscribe, "/unsubscribe?code=unsub+code"))
+
+		parsed, err := mail.ReadMessage(strings.NewReader(string(msg.bytes("noreply@test.com"))))
+		require.Nil(t, err)
+		assert.Equal(t, "<"+msg.ListUnsubscribe+">", parsed.Header.Get("List-Unsubscribe"))
+		assert.Equal(t, "List-Unsubscribe=One-Click", parsed.Header.Get("List-Unsubscribe-Post"))
+	})
+
+	t.Run("Other emails have no list unsubscribe headers", func(t *testing.T) {
+		r := NewRecorder()
+		require.Nil(t, SendVerificationCode(r, "code", "042917", "lifter@test.com", "en"))
+
+		parsed, err := mail.ReadMessage(strings.NewReader(string(r.Messages()[0].bytes("noreply@test.com"))))
+		require.Nil(t, err)
+		assert.Empty(t, parsed.Header.Get("List-Unsubscribe"))
+		assert.Empty(t, parsed.Header.Get("List-Unsubscribe-Post"))
+	})
+}
@@ -0,0 +1,119 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/config"
+)
+
+// Message is a rendered email ready to be delivered
+type Message struct {
+	// one of the Kind constants
+	Kind    string
+	To      []string
+	Subject string
+	HTML    string
+	// plain text alternative to HTML, the message is html only when empty
+	Text string
+	// url mail clients can post to unsubscribe in one click, see RFC 8058
+	ListUnsubscribe string
+}
+
+// Mailer delivers messages, LoadMailer picks one from the env
+type Mailer interface {
+	Send(msg *Message) error
+}
+
+// LoadMailer returns the mailer chosen by MAIL_TRANSPORT, smtp by default
+func LoadMailer() (Mailer, error) {
+	switch transport := os.Getenv(config.MAIL_TRANSPORT); transport {
+	case "", "smtp":
+		return LoadSMTPMailer()
+	case "file":
+		dir := os.Getenv(config.MAIL_DIR)
+		if dir == "" {
+			dir = "./maildir"
+		}
+		return NewFileMailer(dir, os.Getenv(config.EMAIL))
+	case "memory":
+		return NewRecorder(), nil
+	default:
+		return nil, fmt.Errorf("%s: unknown mail transport %q", config.MAIL_TRANSPORT, transport)
+	}
+}
+
+// bytes renders msg with its headers as it is sent over the wire, as
+// multipart/alternative when it has a plain text part
+func (msg *Message) bytes(from string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	if msg.ListUnsubscribe != "" {
+		fmt.Fprintf(&b, "List-Unsubscribe: <%s>\r\n", msg.ListUnsubscribe)
+		b.WriteString("List-Unsubscribe-Post: List-Unsubscribe=One-Click\r\n")
+	}
+	b.WriteString("MIME-Version: 1.0\r\n")
+
+	if msg.Text == "" {
+		b.WriteString("Content-Type: text/html; charset=\"UTF-8\"\r\n")
+		b.WriteString("\r\n")
+		b.WriteString(msg.HTML)
+		return b.Bytes()
+	}
+
+	w := multipart.NewWriter(&b)
+	fmt.Fprintf(&b, "Content-Type: multipart/alternative; boundary=%q\r\n", w.Boundary())
+	b.WriteString("\r\n")
+	// clients show the last part they understand so plain text goes first
+	writePart(w, "text/plain", msg.Text)
+	writePart(w, "text/html", msg.HTML)
+	w.Close()
+	return b.Bytes()
+}
+
+func writePart(w *multipart.Writer, contentType string, body string) {
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Type", contentType+"; charset=\"UTF-8\"")
+	h.Set("Content-Transfer-Encoding", "quoted-printable")
+	// writes go to a bytes.Buffer so can't fail
+	part, _ := w.CreatePart(h)
+	qp := quotedprintable.NewWriter(part)
+	qp.Write([]byte(body))
+	qp.Close()
+}
+
+// Recorder keeps messages in memory instead of sending them so tests can
+// check what would have been sent
+type Recorder struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) Send(msg *Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, *msg)
+	return nil
+}
+
+// Messages returns everything sent so far, oldest first
+func (r *Recorder) Messages() []Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Message(nil), r.messages...)
+}
@@ -0,0 +1,161 @@
+package mail
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/neilZon/workout-logger-api/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts one message and sends the commands it received and
+// the message data on the returned channels
+func fakeSMTPServer(t *testing.T) (string, <-chan []string, <-chan string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	commands := make(chan []string, 1)
+	data := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		var received []string
+		reply("220 localhost ready")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			received = append(received, line)
+
+			switch {
+			case strings.HasPrefix(line, "EHLO"):
+				reply("250-localhost")
+				reply("250 AUTH LOGIN PLAIN")
+			case strings.HasPrefix(line, "AUTH PLAIN"):
+				reply("235 ok")
+			case line == "DATA":
+				reply("354 go ahead")
+				var msg strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil || l == ".\r\n" {
+						break
+					}
+					msg.WriteString(l)
+				}
+				data <- msg.String()
+				reply("250 ok")
+			case line == "QUIT":
+				reply("221 bye")
+				commands <- received
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+
+	return l.Addr().String(), commands, data
+}
+
+func TestMailers(t *testing.T) {
+	msg := &Message{
+		To:      []string{"lifter@test.com"},
+		Subject: "Email Verification!",
+		HTML:    "<p>hello</p>",
+	}
+
+	t.Run("Recorder keeps sent messages", func(t *testing.T) {
+		r := NewRecorder()
+		require.Nil(t, r.Send(msg))
+		require.Nil(t, r.Send(msg))
+		assert.Len(t, r.Messages(), 2)
+		assert.Equal(t, "lifter@test.com", r.Messages()[0].To[0])
+	})
+
+	t.Run("File mailer writes messages to a maildir", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "maildir")
+		f, err := NewFileMailer(dir, "noreply@test.com")
+		require.Nil(t, err)
+		require.Nil(t, f.Send(msg))
+
+		tmp, err := os.ReadDir(filepath.Join(dir, "tmp"))
+		require.Nil(t, err)
+		assert.Empty(t, tmp)
+
+		delivered, err := os.ReadDir(filepath.Join(dir, "new"))
+		require.Nil(t, err)
+		require.Len(t, delivered, 1)
+
+		contents, err := os.ReadFile(filepath.Join(dir, "new", delivered[0].Name()))
+		require.Nil(t, err)
+		assert.Contains(t, string(contents), "From: noreply@test.com\r\n")
+		assert.Contains(t, string(contents), "To: lifter@test.com\r\n")
+		assert.Contains(t, string(contents), "Subject: Email Verification!\r\n")
+		assert.True(t, strings.HasSuffix(string(contents), "\r\n\r\n<p>hello</p>"))
+	})
+
+	t.Run("Smtp mailer sends through the configured server", func(t *testing.T) {
+		addr, commands, data := fakeSMTPServer(t)
+		host, port, err := net.SplitHostPort(addr)
+		require.Nil(t, err)
+
+		t.Setenv(config.EMAIL, "noreply@test.com")
+		t.Setenv(config.APP_PASSWORD, "secret")
+		t.Setenv(config.SMTP_HOST, host)
+		t.Setenv(config.SMTP_PORT, port)
+		t.Setenv(config.SMTP_TLS, TLSNone)
+		t.Setenv(config.SMTP_AUTH, AuthPlain)
+
+		s, err := LoadSMTPMailer()
+		require.Nil(t, err)
+		p, _ := strconv.Atoi(port)
+		assert.Equal(t, p, s.Port)
+
+		require.Nil(t, s.Send(msg))
+
+		received := <-commands
+		assert.Contains(t, received, "MAIL FROM:<noreply@test.com>")
+		assert.Contains(t, received, "RCPT TO:<lifter@test.com>")
+		assert.Contains(t, <-data, "<p>hello</p>")
+	})
+
+	t.Run("Smtp mailer rejects unknown settings", func(t *testing.T) {
+		t.Setenv(config.SMTP_TLS, "ssl3")
+		_, err := LoadSMTPMailer()
+		assert.EqualError(t, err, `SMTP_TLS: unknown tls mode "ssl3"`)
+	})
+
+	t.Run("Loads the mailer chosen in the env", func(t *testing.T) {
+		t.Setenv(config.MAIL_TRANSPORT, "memory")
+		m, err := LoadMailer()
+		require.Nil(t, err)
+		assert.IsType(t, &Recorder{}, m)
+
+		t.Setenv(config.MAIL_TRANSPORT, "file")
+		t.Setenv(config.MAIL_DIR, t.TempDir())
+		m, err = LoadMailer()
+		require.Nil(t, err)
+		assert.IsType(t, &FileMailer{}, m)
+
+		t.Setenv(config.MAIL_TRANSPORT, "pigeon")
+		_, err = LoadMailer()
+		assert.NotNil(t, err)
+	})
+}
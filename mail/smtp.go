@@ -0,0 +1,195 @@
+package mail
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+
+	"github.com/neilZon/workout-logger-api/config"
+)
+
+// how the connection to the smtp server is secured
+const (
+	TLSStartTLS = "starttls"
+	TLSImplicit = "tls"
+	TLSNone     = "none"
+)
+
+// how the smtp server is logged in to
+const (
+	AuthLogin   = "login"
+	AuthPlain   = "plain"
+	AuthCRAMMD5 = "cram-md5"
+	AuthNone    = "none"
+)
+
+// SMTPMailer sends messages through an smtp server
+type SMTPMailer struct {
+	Host     string
+	Port     int
+	TLS      string
+	Auth     string
+	Username string
+	Password string
+	From     string
+}
+
+// LoadSMTPMailer reads the smtp server from SMTP_HOST, SMTP_PORT, SMTP_TLS and
+// SMTP_AUTH, defaulting to gmail. Messages are sent from EMAIL which is also the
+// username unless SMTP_USERNAME is set, APP_PASSWORD is the password.
+func LoadSMTPMailer() (*SMTPMailer, error) {
+	s := &SMTPMailer{
+		Host:     "smtp.gmail.com",
+		Port:     587,
+		TLS:      TLSStartTLS,
+		Auth:     AuthLogin,
+		Username: os.Getenv(config.EMAIL),
+		Password: os.Getenv(config.APP_PASSWORD),
+		From:     os.Getenv(config.EMAIL),
+	}
+
+	if v := os.Getenv(config.SMTP_HOST); v != "" {
+		s.Host = v
+	}
+	if v := os.Getenv(config.SMTP_PORT); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", config.SMTP_PORT, err)
+		}
+		s.Port = port
+	}
+	if v := os.Getenv(config.SMTP_TLS); v != "" {
+		switch v {
+		case TLSStartTLS, TLSImplicit, TLSNone:
+			s.TLS = v
+		default:
+			return nil, fmt.Errorf("%s: unknown tls mode %q", config.SMTP_TLS, v)
+		}
+	}
+	if v := os.Getenv(config.SMTP_AUTH); v != "" {
+		switch v {
+		case AuthLogin, AuthPlain, AuthCRAMMD5, AuthNone:
+			s.Auth = v
+		default:
+			return nil, fmt.Errorf("%s: unknown auth type %q", config.SMTP_AUTH, v)
+		}
+	}
+	if v := os.Getenv(config.SMTP_USERNAME); v != "" {
+		s.Username = v
+	}
+
+	return s, nil
+}
+
+func (s *SMTPMailer) Send(msg *Message) error {
+	c, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if auth := s.auth(); auth != nil {
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(s.From); err != nil {
+		return err
+	}
+	for _, to := range msg.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg.bytes(s.From)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+func (s *SMTPMailer) dial() (*smtp.Client, error) {
+	addr := net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+	tlsConfig := &tls.Config{ServerName: s.Host}
+
+	if s.TLS == TLSImplicit {
+		conn, err := tls.Dial("tcp", addr, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		c, err := smtp.NewClient(conn, s.Host)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return c, nil
+	}
+
+	c, err := smtp.Dial(addr)
+	if err != nil {
+		return nil, err
+	}
+	if s.TLS == TLSStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			c.Close()
+			return nil, errors.New("smtp server does not support STARTTLS")
+		}
+		if err := c.StartTLS(tlsConfig); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (s *SMTPMailer) auth() smtp.Auth {
+	switch s.Auth {
+	case AuthPlain:
+		return smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	case AuthCRAMMD5:
+		return smtp.CRAMMD5Auth(s.Username, s.Password)
+	case AuthNone:
+		return nil
+	default:
+		return LoginAuth(s.Username, s.Password)
+	}
+}
+
+type loginAuth struct {
+	username, password string
+}
+
+func LoginAuth(username, password string) smtp.Auth {
+	return &loginAuth{username, password}
+}
+
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	return "LOGIN", []byte{}, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if more {
+		switch string(fromServer) {
+		case "Username:":
+			return []byte(a.username), nil
+		case "Password:":
+			return []byte(a.password), nil
+		default:
+			return nil, errors.New("Unkown fromServer")
+		}
+	}
+	return nil, nil
+}
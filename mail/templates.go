@@ -0,0 +1,124 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// DefaultLocale is used for users without a locale or whose locale has no templates
+const DefaultLocale = "en"
+
+// templates/<locale>/<kind>.html is the html part of an email and <kind>.txt
+// the plain text part, which also defines its "subject"
+//
+//go:embed templates
+var templateFS embed.FS
+
+type localeTemplates struct {
+	html map[string]*htmltemplate.Template
+	text map[string]*texttemplate.Template
+}
+
+var locales = mustLoadTemplates(templateFS)
+
+// how each locale writes dates in emails
+var dateFormats = map[string]func(time.Time) string{
+	"en": func(t time.Time) string { return t.UTC().Format("January 2, 2006") },
+	"es": func(t time.Time) string {
+		months := [...]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio",
+			"agosto", "septiembre", "octubre", "noviembre", "diciembre"}
+		t = t.UTC()
+		return fmt.Sprintf("%d de %s de %d", t.Day(), months[t.Month()-1], t.Year())
+	},
+}
+
+func mustLoadTemplates(fsys fs.FS) map[string]*localeTemplates {
+	loaded := make(map[string]*localeTemplates)
+
+	dirs, err := fs.ReadDir(fsys, "templates")
+	if err != nil {
+		panic(err)
+	}
+	for _, dir := range dirs {
+		locale := dir.Name()
+		date, ok := dateFormats[locale]
+		if !ok {
+			date = dateFormats[DefaultLocale]
+		}
+		funcs := map[string]interface{}{"date": date}
+
+		lt := &localeTemplates{
+			html: make(map[string]*htmltemplate.Template),
+			text: make(map[string]*texttemplate.Template),
+		}
+		files, err := fs.ReadDir(fsys, path.Join("templates", locale))
+		if err != nil {
+			panic(err)
+		}
+		for _, f := range files {
+			name := path.Join("templates", locale, f.Name())
+			kind := strings.TrimSuffix(f.Name(), path.Ext(f.Name()))
+			switch path.Ext(f.Name()) {
+			case ".html":
+				lt.html[kind] = htmltemplate.Must(htmltemplate.New(f.Name()).Funcs(funcs).ParseFS(fsys, name))
+			case ".txt":
+				lt.text[kind] = texttemplate.Must(texttemplate.New(f.Name()).Funcs(funcs).ParseFS(fsys, name))
+			}
+		}
+		loaded[locale] = lt
+	}
+
+	return loaded
+}
+
+// resolveLocale picks the templates to use for a kind of email, trying the
+// exact locale, then its language and then DefaultLocale
+func resolveLocale(kind string, locale string) (*localeTemplates, error) {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	candidates := []string{locale}
+	if language, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, language)
+	}
+	candidates = append(candidates, DefaultLocale)
+
+	for _, c := range candidates {
+		lt, ok := locales[c]
+		if ok && lt.html[kind] != nil && lt.text[kind] != nil {
+			return lt, nil
+		}
+	}
+	return nil, fmt.Errorf("no templates for %s email", kind)
+}
+
+// render fills in the subject, html and plain text of a kind of email
+func render(kind string, locale string, data interface{}) (*Message, error) {
+	lt, err := resolveLocale(kind, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	var subject, html, text bytes.Buffer
+	if err := lt.text[kind].ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	if err := lt.text[kind].Execute(&text, data); err != nil {
+		return nil, err
+	}
+	if err := lt.html[kind].Execute(&html, data); err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		Kind:    kind,
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    html.String(),
+		Text:    text.String(),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neilZon/workout-logger-api/common"
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/token"
+	"gorm.io/gorm"
+)
+
+// GetAdmin returns the signed in user if they are an admin. The role in the
+// token is checked against the database so a demoted admin loses access
+// straight away instead of when their token expires.
+func GetAdmin(ctx context.Context, db *gorm.DB) (*token.Claims, error) {
+	u, err := GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if u.Role != database.RoleAdmin {
+		return nil, &common.ForbiddenError{}
+	}
+
+	user, err := database.GetUserById(db, fmt.Sprintf("%d", u.ID))
+	if err != nil || user.Role != database.RoleAdmin || user.DisabledAt != nil {
+		return nil, &common.ForbiddenError{}
+	}
+	return u, nil
+}
@@ -3,11 +3,11 @@ package middleware
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
-	"os"
+	"time"
 
 	"github.com/neilZon/workout-logger-api/common"
-	"github.com/neilZon/workout-logger-api/config"
 	"github.com/neilZon/workout-logger-api/database"
 	"github.com/neilZon/workout-logger-api/token"
 	"gorm.io/gorm"
@@ -15,15 +15,29 @@ import (
 
 const UserCtxKey string = "USER"
 
-func AuthMiddleware(next http.Handler) http.Handler {
+// how stale a session's last used time can get before it is written again
+const sessionTouchInterval = 5 * time.Minute
+
+func AuthMiddleware(db *gorm.DB, keys *token.Keys, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		t := r.Header.Get("Authorization")
 
-		// decode token to get user
-		claims, _ := token.Decode(t, []byte(os.Getenv(config.ACCESS_SECRET)))
+		var claims *token.Claims
+		if apiToken, ok := token.IsApiToken(t); ok {
+			claims = apiTokenClaims(db, apiToken)
+		} else {
+			// decode token to get user
+			claims, _ = keys.Access.Decode(t)
+		}
+
+		// tokens issued to a revoked device are treated as anonymous
+		if claims != nil && claims.SessionID != 0 && !sessionIsActive(db, claims.SessionID) {
+			claims = &token.Claims{}
+		}
 
 		// put it in context
 		ctx := context.WithValue(r.Context(), UserCtxKey, claims)
+		ctx = WithVerifiedCache(ctx)
 
 		// and call the next with our new context
 		r = r.WithContext(ctx)
@@ -31,7 +45,9 @@ func AuthMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-func GetUser(ctx context.Context) (*token.Claims, error) {
+// GetClaims returns whoever made the request, by login or api token. What
+// they are allowed to do is checked by the @auth and @scope directives.
+func GetClaims(ctx context.Context) (*token.Claims, error) {
 	u, ok := ctx.Value(UserCtxKey).(*token.Claims)
 	if !ok || u == nil || (token.Claims{}) == *u {
 		return nil, &common.UnauthorizedError{}
@@ -39,6 +55,32 @@ func GetUser(ctx context.Context) (*token.Claims, error) {
 	return u, nil
 }
 
+// GetUser returns the signed in user. Api tokens can't be used for anything
+// that goes through GetUser, only for what GetUserWithScope allows.
+func GetUser(ctx context.Context) (*token.Claims, error) {
+	u, err := GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if u.ApiTokenID != 0 {
+		return nil, &common.ForbiddenError{}
+	}
+	return u, nil
+}
+
+// GetUserWithScope returns the signed in user if they logged in or used an
+// api token granted scope
+func GetUserWithScope(ctx context.Context, scope string) (*token.Claims, error) {
+	u, err := GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !u.HasScope(scope) {
+		return nil, &common.ForbiddenError{}
+	}
+	return u, nil
+}
+
 func VerifyUser(db *gorm.DB, userId string) error {
 	user, err := database.GetUserById(db, userId)
 	if err != nil {
@@ -49,3 +91,32 @@ func VerifyUser(db *gorm.DB, userId string) error {
 	}
 	return nil
 }
+
+func sessionIsActive(db *gorm.DB, sessionId uint) bool {
+	session, err := database.GetSession(db, fmt.Sprintf("%d", sessionId))
+	if err != nil || session.RevokedAt != nil {
+		return false
+	}
+
+	if time.Since(session.LastUsedAt) > sessionTouchInterval {
+		database.TouchSession(db, session.ID)
+	}
+	return true
+}
+
+// apiTokenClaims looks up an api token, unknown, revoked and expired tokens are anonymous
+func apiTokenClaims(db *gorm.DB, plaintext string) *token.Claims {
+	apiToken, err := database.GetActiveApiToken(db, token.HashApiToken(plaintext))
+	if err != nil {
+		return &token.Claims{}
+	}
+
+	if apiToken.LastUsedAt == nil || time.Since(*apiToken.LastUsedAt) > sessionTouchInterval {
+		database.TouchApiToken(db, apiToken.ID)
+	}
+	return &token.Claims{
+		ID:         apiToken.UserID,
+		ApiTokenID: apiToken.ID,
+		Scope:      apiToken.Scopes,
+	}
+}
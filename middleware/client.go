@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/neilZon/workout-logger-api/config"
+)
+
+const ClientCtxKey = ctxKey("CLIENT")
+
+// Client describes where a request came from
+type Client struct {
+	UserAgent string
+	IP        string
+}
+
+// ClientMiddleware records the user agent and ip of the caller in the context
+func ClientMiddleware(next http.Handler) http.Handler {
+	hops := trustedProxyHops()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client := &Client{
+			UserAgent: r.UserAgent(),
+			IP:        clientIP(r, hops),
+		}
+		ctx := context.WithValue(r.Context(), ClientCtxKey, client)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func GetClient(ctx context.Context) *Client {
+	c, ok := ctx.Value(ClientCtxKey).(*Client)
+	if !ok || c == nil {
+		return &Client{}
+	}
+	return c
+}
+
+func trustedProxyHops() int {
+	hops, err := strconv.Atoi(os.Getenv(config.TRUSTED_PROXY_HOPS))
+	if err != nil || hops < 0 {
+		return 1
+	}
+	return hops
+}
+
+// clientIP takes the address the outermost of our hops proxies appended to
+// X-Forwarded-For. Anything left of it was sent by the caller and can't be
+// trusted, the ip is used to key rate limits.
+func clientIP(r *http.Request, hops int) string {
+	if forwarded := r.Header.Values("X-Forwarded-For"); hops > 0 && len(forwarded) > 0 {
+		addrs := strings.Split(strings.Join(forwarded, ","), ",")
+		i := len(addrs) - hops
+		if i < 0 {
+			i = 0
+		}
+		return strings.TrimSpace(addrs[i])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+const VerifiedCtxKey = ctxKey("VERIFIED")
+
+// verifiedCache remembers whether the user making a request is verified so
+// a query resolving many guarded fields only looks it up once
+type verifiedCache struct {
+	once sync.Once
+	err  error
+}
+
+// WithVerifiedCache gives a request somewhere to keep the result of IsVerified
+func WithVerifiedCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, VerifiedCtxKey, &verifiedCache{})
+}
+
+// IsVerified returns an error if the signed in user hasn't verified their
+// email. Requests without a cache look it up every time.
+func IsVerified(ctx context.Context, db *gorm.DB) error {
+	u, err := GetClaims(ctx)
+	if err != nil {
+		return err
+	}
+
+	cache, ok := ctx.Value(VerifiedCtxKey).(*verifiedCache)
+	if !ok || cache == nil {
+		return VerifyUser(db, fmt.Sprintf("%d", u.ID))
+	}
+	cache.once.Do(func() {
+		cache.err = VerifyUser(db, fmt.Sprintf("%d", u.ID))
+	})
+	return cache.err
+}
@@ -0,0 +1,178 @@
+// Package oidc verifies ID tokens from OpenID Connect providers such as
+// Google and Apple so they can be exchanged for our own tokens
+
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/neilZon/workout-logger-api/config"
+)
+
+// allowance for clock drift between us and the provider
+const leeway = time.Minute
+
+var (
+	ErrUnknownProvider = errors.New("unknown oidc provider")
+	ErrInvalidToken    = errors.New("invalid id token")
+)
+
+// Provider is an issuer we accept ID tokens from
+type Provider struct {
+	Name   string
+	Issuer string
+	// our client ids registered with the provider, the token must be issued to one of them
+	ClientIDs []string
+	// discovered from the issuer when empty
+	JWKSURL string
+}
+
+// Identity is who the provider says signed in
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type idTokenClaims struct {
+	Email string `json:"email"`
+	// apple sends this as the string "true"
+	EmailVerified interface{} `json:"email_verified"`
+	Name          string      `json:"name"`
+	Nonce         string      `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+func (c *idTokenClaims) emailVerified() bool {
+	switch v := c.EmailVerified.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// Verifier checks ID tokens against the configured providers
+type Verifier struct {
+	providers map[string]*Provider
+	keys      map[string]*keyCache
+	Client    *http.Client
+	Now       func() time.Time
+}
+
+func NewVerifier(providers ...*Provider) *Verifier {
+	v := &Verifier{
+		providers: map[string]*Provider{},
+		keys:      map[string]*keyCache{},
+		Client:    &http.Client{Timeout: 10 * time.Second},
+		Now:       time.Now,
+	}
+	for _, p := range providers {
+		v.providers[p.Name] = p
+		v.keys[p.Name] = &keyCache{provider: p}
+	}
+	return v
+}
+
+// LoadProviders reads the providers named in OIDC_PROVIDERS, each configured
+// with OIDC_<NAME>_ISSUER, OIDC_<NAME>_CLIENT_IDS and optionally OIDC_<NAME>_JWKS_URL
+func LoadProviders() ([]*Provider, error) {
+	var providers []*Provider
+	for _, name := range splitList(os.Getenv(config.OIDC_PROVIDERS)) {
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		p := &Provider{
+			Name:      strings.ToLower(name),
+			Issuer:    os.Getenv(prefix + "ISSUER"),
+			ClientIDs: splitList(os.Getenv(prefix + "CLIENT_IDS")),
+			JWKSURL:   os.Getenv(prefix + "JWKS_URL"),
+		}
+		if p.Issuer == "" || len(p.ClientIDs) == 0 {
+			return nil, fmt.Errorf("oidc provider %s needs an issuer and client ids", name)
+		}
+		providers = append(providers, p)
+	}
+	return providers, nil
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// Verify checks the signature, issuer, audience, expiry and nonce of an ID
+// token issued by the named provider. Callers make sure the nonce is one they
+// handed out and only accept it once.
+func (v *Verifier) Verify(ctx context.Context, provider string, idToken string, nonce string) (*Identity, error) {
+	p, ok := v.providers[strings.ToLower(provider)]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	cache := v.keys[p.Name]
+
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{"RS256", "ES256"}),
+		// checked below against our clock
+		jwt.WithoutClaimsValidation(),
+	)
+	claims := &idTokenClaims{}
+	_, err := parser.ParseWithClaims(idToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return cache.key(ctx, v, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	now := v.Now()
+	if !claims.VerifyIssuer(p.Issuer, true) {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, claims.Issuer)
+	}
+	if !audienceAllowed(claims, p.ClientIDs) {
+		return nil, fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+	}
+	if !claims.VerifyExpiresAt(now.Add(-leeway), true) {
+		return nil, fmt.Errorf("%w: token expired", ErrInvalidToken)
+	}
+	if !claims.VerifyIssuedAt(now.Add(leeway), false) || !claims.VerifyNotBefore(now.Add(leeway), false) {
+		return nil, fmt.Errorf("%w: token used before issued", ErrInvalidToken)
+	}
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: unexpected nonce", ErrInvalidToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	}
+
+	return &Identity{
+		Provider:      p.Name,
+		Subject:       claims.Subject,
+		Email:         strings.ToLower(claims.Email),
+		EmailVerified: claims.emailVerified(),
+		Name:          claims.Name,
+	}, nil
+}
+
+func audienceAllowed(claims *idTokenClaims, clientIds []string) bool {
+	for _, id := range clientIds {
+		if claims.VerifyAudience(id, true) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// issuer stands in for a provider like Google, serving discovery and JWKS
+type issuer struct {
+	*httptest.Server
+	key      *rsa.PrivateKey
+	kid      string
+	jwksHits int32
+}
+
+func newIssuer(t *testing.T) *issuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	iss := &issuer{key: key, kid: "key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   iss.URL,
+			"jwks_uri": iss.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&iss.jwksHits, 1)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": iss.kid,
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(iss.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(iss.key.E)).Bytes()),
+			}},
+		})
+	})
+	iss.Server = httptest.NewServer(mux)
+	t.Cleanup(iss.Close)
+	return iss
+}
+
+func (iss *issuer) sign(t *testing.T, claims jwt.MapClaims) string {
+	tkn := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tkn.Header["kid"] = iss.kid
+	s, err := tkn.SignedString(iss.key)
+	require.Nil(t, err)
+	return s
+}
+
+func TestVerifier(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	newVerifier := func(iss *issuer) *Verifier {
+		v := NewVerifier(&Provider{Name: "google", Issuer: iss.URL, ClientIDs: []string{"client-1"}})
+		v.Now = func() time.Time { return now }
+		return v
+	}
+
+	claims := func(iss *issuer) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            iss.URL,
+			"aud":            "client-1",
+			"sub":            "1234",
+			"email":          "Test@test.com",
+			"email_verified": true,
+			"name":           "testname",
+			"nonce":          "nonce-1",
+			"iat":            now.Unix(),
+			"exp":            now.Add(time.Hour).Unix(),
+		}
+	}
+
+	t.Run("Verifies a token from the issuer", func(t *testing.T) {
+		iss := newIssuer(t)
+		v := newVerifier(iss)
+
+		identity, err := v.Verify(context.Background(), "google", iss.sign(t, claims(iss)), "nonce-1")
+		require.Nil(t, err)
+		assert.Equal(t, &Identity{
+			Provider:      "google",
+			Subject:       "1234",
+			Email:         "test@test.com",
+			EmailVerified: true,
+			Name:          "testname",
+		}, identity)
+	})
+
+	t.Run("Caches the issuer's keys", func(t *testing.T) {
+		iss := newIssuer(t)
+		v := newVerifier(iss)
+
+		for i := 0; i < 3; i++ {
+			_, err := v.Verify(context.Background(), "google", iss.sign(t, claims(iss)), "nonce-1")
+			require.Nil(t, err)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&iss.jwksHits))
+
+		now = now.Add(2 * keyCacheTTL)
+		c := claims(iss)
+		c["iat"] = now.Unix()
+		c["exp"] = now.Add(time.Hour).Unix()
+		_, err := v.Verify(context.Background(), "google", iss.sign(t, c), "nonce-1")
+		require.Nil(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&iss.jwksHits), "stale keys should be fetched again")
+	})
+
+	t.Run("Picks up rotated keys", func(t *testing.T) {
+		iss := newIssuer(t)
+		v := newVerifier(iss)
+		_, err := v.Verify(context.Background(), "google", iss.sign(t, claims(iss)), "nonce-1")
+		require.Nil(t, err)
+
+		iss.key, err = rsa.GenerateKey(rand.Reader, 2048)
+		require.Nil(t, err)
+		iss.kid = "key-2"
+		now = now.Add(2 * minRefreshInterval)
+		c := claims(iss)
+		c["iat"] = now.Unix()
+		c["exp"] = now.Add(time.Hour).Unix()
+
+		_, err = v.Verify(context.Background(), "google", iss.sign(t, c), "nonce-1")
+		require.Nil(t, err)
+	})
+
+	t.Run("Rejects bad tokens", func(t *testing.T) {
+		iss := newIssuer(t)
+		v := newVerifier(iss)
+
+		wrongAudience := claims(iss)
+		wrongAudience["aud"] = "someone-else"
+		wrongIssuer := claims(iss)
+		wrongIssuer["iss"] = "https://evil.example.com"
+		expired := claims(iss)
+		expired["exp"] = now.Add(-time.Hour).Unix()
+		noSubject := claims(iss)
+		delete(noSubject, "sub")
+		wrongNonce := claims(iss)
+		wrongNonce["nonce"] = "nonce-2"
+		noNonce := claims(iss)
+		delete(noNonce, "nonce")
+
+		for name, c := range map[string]jwt.MapClaims{
+			"wrong audience": wrongAudience,
+			"wrong issuer":   wrongIssuer,
+			"expired":        expired,
+			"no subject":     noSubject,
+			"wrong nonce":    wrongNonce,
+			"no nonce":       noNonce,
+		} {
+			_, err := v.Verify(context.Background(), "google", iss.sign(t, c), "nonce-1")
+			assert.ErrorIs(t, err, ErrInvalidToken, name)
+		}
+
+		// signed by a key the issuer never published
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.Nil(t, err)
+		forged := jwt.NewWithClaims(jwt.SigningMethodRS256, claims(iss))
+		forged.Header["kid"] = iss.kid
+		forgedToken, err := forged.SignedString(other)
+		require.Nil(t, err)
+		_, err = v.Verify(context.Background(), "google", forgedToken, "nonce-1")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+
+		_, err = v.Verify(context.Background(), "apple", iss.sign(t, claims(iss)), "nonce-1")
+		assert.ErrorIs(t, err, ErrUnknownProvider)
+	})
+
+	t.Run("Reads apple's string email_verified", func(t *testing.T) {
+		iss := newIssuer(t)
+		v := newVerifier(iss)
+
+		c := claims(iss)
+		c["email_verified"] = "false"
+		identity, err := v.Verify(context.Background(), "google", iss.sign(t, c), "nonce-1")
+		require.Nil(t, err)
+		assert.False(t, identity.EmailVerified)
+	})
+}
@@ -0,0 +1,178 @@
+// Package outbox queues email in the database so it is only sent once the
+// change it is about commits, and delivers it in the background with retries.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/config"
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/mail"
+	"gorm.io/gorm"
+)
+
+// TxMailer is a mailer that can queue messages as part of a transaction
+type TxMailer interface {
+	mail.Mailer
+	WithTx(tx *gorm.DB) mail.Mailer
+}
+
+// ForTx returns a mailer that sends as part of tx when m is a TxMailer, so
+// email about a change is only delivered if the change commits
+func ForTx(m mail.Mailer, tx *gorm.DB) mail.Mailer {
+	if txm, ok := m.(TxMailer); ok {
+		return txm.WithTx(tx)
+	}
+	return m
+}
+
+// Mailer queues messages in the outbox for the Worker to deliver
+type Mailer struct {
+	db *gorm.DB
+}
+
+func NewMailer(db *gorm.DB) *Mailer {
+	return &Mailer{db: db}
+}
+
+// WithTx returns a mailer that queues messages in tx so they are dropped if it rolls back
+func (m *Mailer) WithTx(tx *gorm.DB) mail.Mailer {
+	return &Mailer{db: tx}
+}
+
+func (m *Mailer) Send(msg *mail.Message) error {
+	for _, to := range msg.To {
+		err := database.QueueEmail(m.db, &database.OutboxEmail{
+			Kind:            msg.Kind,
+			Recipient:       to,
+			Subject:         msg.Subject,
+			Body:            msg.HTML,
+			TextBody:        msg.Text,
+			ListUnsubscribe: msg.ListUnsubscribe,
+			Status:          database.OutboxPending,
+			NextAttemptAt:   time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Worker delivers queued email through a mailer, backing off exponentially
+// after each failure and giving up on an email after MaxAttempts
+type Worker struct {
+	DB     *gorm.DB
+	Mailer mail.Mailer
+	// attempts before an email is marked dead
+	MaxAttempts int
+	// wait after the first failure, doubled after every failure up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	now func() time.Time
+}
+
+func NewWorker(db *gorm.DB, mailer mail.Mailer) *Worker {
+	return &Worker{
+		DB:          db,
+		Mailer:      mailer,
+		MaxAttempts: config.OUTBOX_MAX_ATTEMPTS,
+		BaseDelay:   config.OUTBOX_BASE_DELAY,
+		MaxDelay:    config.OUTBOX_MAX_DELAY,
+		now:         time.Now,
+	}
+}
+
+// Run delivers due email every interval until ctx is cancelled
+func (w *Worker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.DeliverDue(); err != nil {
+			log.Printf("could not deliver queued email: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue attempts every email that is due and returns how many were attempted
+func (w *Worker) DeliverDue() (int, error) {
+	attempted := 0
+	for {
+		ok, err := w.deliverNext()
+		if err != nil || !ok {
+			return attempted, err
+		}
+		attempted++
+	}
+}
+
+// deliverNext attempts the next due email, returns false when none are due
+func (w *Worker) deliverNext() (bool, error) {
+	tx := w.DB.Begin()
+
+	email, err := database.ClaimDueEmail(tx, w.now())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return false, nil
+	}
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	sendErr := w.Mailer.Send(&mail.Message{
+		Kind:            email.Kind,
+		To:              []string{email.Recipient},
+		Subject:         email.Subject,
+		HTML:            email.Body,
+		Text:            email.TextBody,
+		ListUnsubscribe: email.ListUnsubscribe,
+	})
+	if sendErr == nil {
+		err = database.MarkEmailSent(tx, email.ID, w.now())
+	} else {
+		attempts := email.Attempts + 1
+		status := database.OutboxPending
+		if attempts >= w.MaxAttempts {
+			status = database.OutboxDead
+			log.Printf("giving up on %s email %d after %d attempts: %v", email.Kind, email.ID, attempts, sendErr)
+		}
+		err = database.MarkEmailFailed(tx, email.ID, status, w.now().Add(w.backoff(attempts)), truncate(sendErr.Error(), 512))
+	}
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	return true, tx.Commit().Error
+}
+
+// backoff is how long to wait after the given number of failed attempts
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.BaseDelay
+	for i := 1; i < attempts && delay < w.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > w.MaxDelay {
+		return w.MaxDelay
+	}
+	return delay
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
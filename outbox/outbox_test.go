@@ -0,0 +1,162 @@
+package outbox
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/mail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// helpers.SetupMockDB can't be used here since helpers depends on this package
+func setupMockDB() (sqlmock.Sqlmock, *gorm.DB) {
+	mockDb, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: mockDb,
+	}), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+
+	return mock, gormDB
+}
+
+type failingMailer struct{}
+
+func (failingMailer) Send(*mail.Message) error {
+	return errors.New("connection refused")
+}
+
+var outboxColumns = []string{"id", "kind", "recipient", "subject", "body", "text_body", "status", "attempts", "next_attempt_at"}
+
+func TestMailer(t *testing.T) {
+	t.Run("Queues an email for each recipient", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox_emails"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, mail.KindVerification, "a@test.com", "Email Verification!", "<p>hi</p>", "hi", database.OutboxPending, 0, sqlmock.AnyArg(), "", nil, "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox_emails"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, mail.KindVerification, "b@test.com", "Email Verification!", "<p>hi</p>", "hi", database.OutboxPending, 0, sqlmock.AnyArg(), "", nil, "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectCommit()
+
+		err := gormDB.Transaction(func(tx *gorm.DB) error {
+			return NewMailer(gormDB).WithTx(tx).Send(&mail.Message{
+				Kind:    mail.KindVerification,
+				To:      []string{"a@test.com", "b@test.com"},
+				Subject: "Email Verification!",
+				HTML:    "<p>hi</p>",
+				Text:    "hi",
+			})
+		})
+		require.Nil(t, err)
+
+		require.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestWorker(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Delivers due email and marks it sent", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+		recorder := mail.NewRecorder()
+		w := NewWorker(gormDB, recorder)
+		w.now = func() time.Time { return now }
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox_emails" WHERE (status = $1 AND next_attempt_at <= $2) AND "outbox_emails"."deleted_at" IS NULL ORDER BY next_attempt_at,"outbox_emails"."id" LIMIT 1 FOR UPDATE SKIP LOCKED`)).
+			WithArgs(database.OutboxPending, now).
+			WillReturnRows(sqlmock.NewRows(outboxColumns).
+				AddRow(3, mail.KindVerification, "lifter@test.com", "Email Verification!", "<p>hi</p>", "hi", database.OutboxPending, 0, now))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox_emails" SET "attempts"=attempts + 1,"body"=$1,"last_error"=$2,"sent_at"=$3,"status"=$4,"text_body"=$5`)).
+			WithArgs("", "", now, database.OutboxSent, "", sqlmock.AnyArg(), 3).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox_emails"`)).
+			WillReturnRows(sqlmock.NewRows(outboxColumns))
+		mock.ExpectRollback()
+
+		attempted, err := w.DeliverDue()
+		require.Nil(t, err)
+		assert.Equal(t, 1, attempted)
+
+		require.Len(t, recorder.Messages(), 1)
+		assert.Equal(t, []string{"lifter@test.com"}, recorder.Messages()[0].To)
+		assert.Equal(t, "<p>hi</p>", recorder.Messages()[0].HTML)
+		assert.Equal(t, "hi", recorder.Messages()[0].Text)
+
+		require.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Retries failed email later", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+		w := NewWorker(gormDB, failingMailer{})
+		w.now = func() time.Time { return now }
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox_emails"`)).
+			WillReturnRows(sqlmock.NewRows(outboxColumns).
+				AddRow(3, mail.KindVerification, "lifter@test.com", "Email Verification!", "<p>hi</p>", "hi", database.OutboxPending, 2, now))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox_emails" SET "attempts"=attempts + 1,"last_error"=$1,"next_attempt_at"=$2,"status"=$3`)).
+			WithArgs("connection refused", now.Add(4*w.BaseDelay), database.OutboxPending, sqlmock.AnyArg(), 3).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox_emails"`)).
+			WillReturnRows(sqlmock.NewRows(outboxColumns))
+		mock.ExpectRollback()
+
+		_, err := w.DeliverDue()
+		require.Nil(t, err)
+
+		require.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Gives up after max attempts", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+		w := NewWorker(gormDB, failingMailer{})
+		w.now = func() time.Time { return now }
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox_emails"`)).
+			WillReturnRows(sqlmock.NewRows(outboxColumns).
+				AddRow(3, mail.KindVerification, "lifter@test.com", "Email Verification!", "<p>hi</p>", "hi", database.OutboxPending, w.MaxAttempts-1, now))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox_emails" SET "attempts"=attempts + 1,"body"=$1,"last_error"=$2,"next_attempt_at"=$3,"status"=$4,"text_body"=$5`)).
+			WithArgs("", "connection refused", sqlmock.AnyArg(), database.OutboxDead, "", sqlmock.AnyArg(), 3).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox_emails"`)).
+			WillReturnRows(sqlmock.NewRows(outboxColumns))
+		mock.ExpectRollback()
+
+		_, err := w.DeliverDue()
+		require.Nil(t, err)
+
+		require.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Backoff doubles up to the max delay", func(t *testing.T) {
+		w := &Worker{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+		assert.Equal(t, time.Second, w.backoff(1))
+		assert.Equal(t, 2*time.Second, w.backoff(2))
+		assert.Equal(t, 8*time.Second, w.backoff(4))
+		assert.Equal(t, 10*time.Second, w.backoff(5))
+		assert.Equal(t, 10*time.Second, w.backoff(50))
+	})
+}
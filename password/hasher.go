@@ -0,0 +1,174 @@
+// Package password hashes and checks the strength of user passwords
+
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrUnknownHash = errors.New("password hash is not in a known format")
+
+// Algorithm is a single way of hashing passwords
+type Algorithm interface {
+	Hash(password string) (string, error)
+	Verify(hash string, password string) (bool, error)
+	// Recognizes reports whether hash was made by this algorithm
+	Recognizes(hash string) bool
+	// Current reports whether hash was made with this algorithm's current parameters
+	Current(hash string) bool
+}
+
+// Hasher hashes new passwords with its preferred algorithm and verifies
+// hashes made by any algorithm it knows, so hashes can be upgraded as
+// users log in
+type Hasher struct {
+	preferred  Algorithm
+	algorithms []Algorithm
+}
+
+func NewHasher(preferred Algorithm, others ...Algorithm) *Hasher {
+	return &Hasher{
+		preferred:  preferred,
+		algorithms: append([]Algorithm{preferred}, others...),
+	}
+}
+
+// DefaultHasher hashes with argon2id and still verifies bcrypt hashes
+func DefaultHasher() *Hasher {
+	return NewHasher(DefaultArgon2id(), Bcrypt{Cost: bcrypt.DefaultCost})
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	return h.preferred.Hash(password)
+}
+
+// Verify checks password against hash. rehash is true when the password was
+// right but the hash should be replaced with one from Hash.
+func (h *Hasher) Verify(hash string, password string) (ok bool, rehash bool, err error) {
+	for _, a := range h.algorithms {
+		if !a.Recognizes(hash) {
+			continue
+		}
+		ok, err = a.Verify(hash, password)
+		if err != nil || !ok {
+			return false, false, err
+		}
+		return true, a != h.preferred || !a.Current(hash), nil
+	}
+	return false, false, ErrUnknownHash
+}
+
+// Bcrypt hashes are kept for existing users, bcrypt ignores anything past 72 bytes
+type Bcrypt struct {
+	Cost int
+}
+
+func (b Bcrypt) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), b.Cost)
+	return string(hash), err
+}
+
+func (b Bcrypt) Verify(hash string, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (b Bcrypt) Recognizes(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func (b Bcrypt) Current(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err == nil && cost == b.Cost
+}
+
+// Argon2id hashes are stored in the PHC string format
+// $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<key>
+type Argon2id struct {
+	Time    uint32
+	Memory  uint32 // KiB
+	Threads uint8
+	KeyLen  uint32
+	SaltLen uint32
+}
+
+// DefaultArgon2id uses the parameters recommended by RFC 9106 for memory constrained servers
+func DefaultArgon2id() Argon2id {
+	return Argon2id{
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 2,
+		KeyLen:  32,
+		SaltLen: 16,
+	}
+}
+
+var b64 = base64.RawStdEncoding
+
+func (a Argon2id) Hash(password string) (string, error) {
+	salt := make([]byte, a.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, a.Time, a.Memory, a.Threads, a.KeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, a.Memory, a.Time, a.Threads, b64.EncodeToString(salt), b64.EncodeToString(key)), nil
+}
+
+func (a Argon2id) Verify(hash string, password string) (bool, error) {
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return false, err
+	}
+	other := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (a Argon2id) Recognizes(hash string) bool {
+	return strings.HasPrefix(hash, "$argon2id$")
+}
+
+func (a Argon2id) Current(hash string) bool {
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return false
+	}
+	return params.Time == a.Time && params.Memory == a.Memory && params.Threads == a.Threads &&
+		uint32(len(key)) == a.KeyLen && uint32(len(salt)) == a.SaltLen
+}
+
+func decodeArgon2id(hash string) (params Argon2id, salt []byte, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, ErrUnknownHash
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrUnknownHash
+	}
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads); err != nil {
+		return params, nil, nil, ErrUnknownHash
+	}
+
+	salt, err = b64.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrUnknownHash
+	}
+	key, err = b64.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, ErrUnknownHash
+	}
+	return params, salt, key, nil
+}
@@ -0,0 +1,112 @@
+package password
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/neilZon/workout-logger-api/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// cheap parameters so the tests run quickly
+var testArgon2id = Argon2id{Time: 1, Memory: 1024, Threads: 1, KeyLen: 32, SaltLen: 16}
+
+func TestHasher(t *testing.T) {
+	t.Run("Argon2id hashes verify", func(t *testing.T) {
+		h := NewHasher(testArgon2id)
+		hash, err := h.Hash("password123")
+		require.Nil(t, err)
+		assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+		ok, rehash, err := h.Verify(hash, "password123")
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.False(t, rehash)
+
+		ok, _, err = h.Verify(hash, "password124")
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Bcrypt hashes verify and ask to be rehashed", func(t *testing.T) {
+		h := NewHasher(testArgon2id, Bcrypt{Cost: bcrypt.MinCost})
+		old, err := Bcrypt{Cost: bcrypt.MinCost}.Hash("password123")
+		require.Nil(t, err)
+
+		ok, rehash, err := h.Verify(old, "password123")
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.True(t, rehash)
+
+		ok, rehash, err = h.Verify(old, "wrongpassword1")
+		require.Nil(t, err)
+		assert.False(t, ok)
+		assert.False(t, rehash, "wrong passwords should never trigger a rehash")
+	})
+
+	t.Run("Changed parameters ask to be rehashed", func(t *testing.T) {
+		hash, err := testArgon2id.Hash("password123")
+		require.Nil(t, err)
+
+		stronger := testArgon2id
+		stronger.Time = 2
+		ok, rehash, err := NewHasher(stronger).Verify(hash, "password123")
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.True(t, rehash)
+	})
+
+	t.Run("Unknown hashes are an error", func(t *testing.T) {
+		_, _, err := NewHasher(testArgon2id).Verify("plaintext", "plaintext")
+		assert.ErrorIs(t, err, ErrUnknownHash)
+	})
+}
+
+func TestPolicy(t *testing.T) {
+	t.Run("Default policy", func(t *testing.T) {
+		p := DefaultPolicy()
+		cases := map[string]string{
+			"bowo":                    "password needs to be between 8 and 128 characters",
+			strings.Repeat("a1", 65):  "password needs to be between 8 and 128 characters",
+			"passwords":               "password needs at least 1 number",
+			"Password123":             "password is too common, choose another",
+			"testname4life":           "password can't contain your email or name",
+			"i-am-neilzon99":          "password can't contain your email or name",
+			"correct horse battery 9": "",
+		}
+		for password, expected := range cases {
+			err := p.Validate(password, "neilzon@test.com", "testname")
+			if expected == "" {
+				assert.Nil(t, err, password)
+			} else {
+				assert.EqualError(t, err, expected, password)
+			}
+		}
+	})
+
+	t.Run("Loads overrides from the env", func(t *testing.T) {
+		list := filepath.Join(t.TempDir(), "breached.txt")
+		require.Nil(t, os.WriteFile(list, []byte("Hunter2Hunter2!\n"), 0600))
+
+		t.Setenv(config.PASSWORD_MIN_LENGTH, "12")
+		t.Setenv(config.PASSWORD_REQUIRE, "upper, symbol")
+		t.Setenv(config.BREACHED_PASSWORDS_FILE, list)
+
+		p, err := LoadPolicy()
+		require.Nil(t, err)
+		assert.EqualError(t, p.Validate("password12345", "a@b.com", ""), "password needs at least 1 uppercase letter")
+		assert.EqualError(t, p.Validate("Password12345", "a@b.com", ""), "password needs at least 1 symbol")
+		assert.EqualError(t, p.Validate("HUNTER2hunter2!", "a@b.com", ""), "password is too common, choose another")
+		assert.Nil(t, p.Validate("Lift heavy things!", "a@b.com", ""))
+	})
+
+	t.Run("Rejects unknown character classes", func(t *testing.T) {
+		t.Setenv(config.PASSWORD_REQUIRE, "emoji")
+		_, err := LoadPolicy()
+		assert.NotNil(t, err)
+	})
+}
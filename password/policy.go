@@ -0,0 +1,166 @@
+package password
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/neilZon/workout-logger-api/config"
+)
+
+// a short list of the most common leaked passwords, a fuller list can be
+// pointed to with BREACHED_PASSWORDS_FILE
+//
+//go:embed breached-passwords.txt
+var breachedPasswords string
+
+// character classes a policy can require
+const (
+	Number = "number"
+	Upper  = "upper"
+	Lower  = "lower"
+	Symbol = "symbol"
+)
+
+// Policy is what a new password has to satisfy
+type Policy struct {
+	MinLength int
+	MaxLength int
+	Require   []string
+	breached  map[string]struct{}
+}
+
+func DefaultPolicy() *Policy {
+	p := &Policy{
+		MinLength: 8,
+		MaxLength: 128,
+		Require:   []string{Number},
+	}
+	p.breached = readList(strings.NewReader(breachedPasswords))
+	return p
+}
+
+// LoadPolicy starts from the default policy and applies PASSWORD_MIN_LENGTH,
+// PASSWORD_MAX_LENGTH, PASSWORD_REQUIRE and BREACHED_PASSWORDS_FILE when set
+func LoadPolicy() (*Policy, error) {
+	p := DefaultPolicy()
+
+	if v := os.Getenv(config.PASSWORD_MIN_LENGTH); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", config.PASSWORD_MIN_LENGTH, err)
+		}
+		p.MinLength = n
+	}
+	if v := os.Getenv(config.PASSWORD_MAX_LENGTH); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", config.PASSWORD_MAX_LENGTH, err)
+		}
+		p.MaxLength = n
+	}
+	if p.MinLength > p.MaxLength {
+		return nil, errors.New("password min length is longer than the max length")
+	}
+
+	// "none" turns off the character class rules
+	if v := os.Getenv(config.PASSWORD_REQUIRE); v != "" {
+		p.Require = nil
+		for _, class := range strings.Split(v, ",") {
+			class = strings.TrimSpace(class)
+			switch class {
+			case "", "none":
+			case Number, Upper, Lower, Symbol:
+				p.Require = append(p.Require, class)
+			default:
+				return nil, fmt.Errorf("%s: unknown character class %q", config.PASSWORD_REQUIRE, class)
+			}
+		}
+	}
+
+	if path := os.Getenv(config.BREACHED_PASSWORDS_FILE); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		for password := range readList(f) {
+			p.breached[password] = struct{}{}
+		}
+	}
+
+	return p, nil
+}
+
+func readList(r io.Reader) map[string]struct{} {
+	list := map[string]struct{}{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		list[strings.ToLower(line)] = struct{}{}
+	}
+	return list
+}
+
+// Validate returns an error describing the first rule password breaks. The
+// email and name are rejected as part of the password because they are the
+// first thing someone guessing would try.
+func (p *Policy) Validate(password string, email string, name string) error {
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength || length > p.MaxLength {
+		return fmt.Errorf("password needs to be between %d and %d characters", p.MinLength, p.MaxLength)
+	}
+
+	for _, class := range p.Require {
+		if !hasClass(password, class) {
+			switch class {
+			case Number:
+				return errors.New("password needs at least 1 number")
+			case Upper:
+				return errors.New("password needs at least 1 uppercase letter")
+			case Lower:
+				return errors.New("password needs at least 1 lowercase letter")
+			case Symbol:
+				return errors.New("password needs at least 1 symbol")
+			}
+		}
+	}
+
+	lower := strings.ToLower(password)
+	if _, ok := p.breached[lower]; ok {
+		return errors.New("password is too common, choose another")
+	}
+
+	localPart := strings.ToLower(strings.Split(email, "@")[0])
+	for _, personal := range append([]string{localPart}, strings.Fields(strings.ToLower(name))...) {
+		// very short names match too many passwords by chance
+		if utf8.RuneCountInString(personal) >= 3 && strings.Contains(lower, personal) {
+			return errors.New("password can't contain your email or name")
+		}
+	}
+
+	return nil
+}
+
+func hasClass(password string, class string) bool {
+	for _, c := range password {
+		switch {
+		case class == Number && unicode.IsDigit(c),
+			class == Upper && unicode.IsUpper(c),
+			class == Lower && unicode.IsLower(c),
+			class == Symbol && !unicode.IsLetter(c) && !unicode.IsDigit(c) && !unicode.IsSpace(c):
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// how often expired windows are swept out of memory
+const sweepInterval = time.Minute
+
+type window struct {
+	count   int
+	resetAt time.Time
+}
+
+// MemoryStore keeps counts in process. Only suitable when a single
+// instance of the api is running, e.g. local development and tests.
+type MemoryStore struct {
+	mu        sync.Mutex
+	windows   map[string]*window
+	nextSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		windows: map[string]*window{},
+		now:     time.Now,
+	}
+}
+
+func (m *MemoryStore) Hit(ctx context.Context, key string, d time.Duration) (int, time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	if now.After(m.nextSweep) {
+		for k, w := range m.windows {
+			if !now.Before(w.resetAt) {
+				delete(m.windows, k)
+			}
+		}
+		m.nextSweep = now.Add(sweepInterval)
+	}
+
+	w, ok := m.windows[key]
+	if !ok || !now.Before(w.resetAt) {
+		w = &window{resetAt: now.Add(d)}
+		m.windows[key] = w
+	}
+	w.count++
+
+	return w.count, w.resetAt, nil
+}
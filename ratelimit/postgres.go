@@ -0,0 +1,31 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/database"
+	"gorm.io/gorm"
+)
+
+// PostgresStore keeps counts in the rate_limits table so limits hold
+// across every running instance of the api
+type PostgresStore struct {
+	DB  *gorm.DB
+	now func() time.Time
+}
+
+func NewPostgresStore(db *gorm.DB) *PostgresStore {
+	return &PostgresStore{
+		DB:  db,
+		now: time.Now,
+	}
+}
+
+func (p *PostgresStore) Hit(ctx context.Context, key string, d time.Duration) (int, time.Time, error) {
+	rl, err := database.HitRateLimit(p.DB.WithContext(ctx), key, p.now(), d)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return rl.Count, rl.ResetAt, nil
+}
@@ -0,0 +1,50 @@
+// Package ratelimit counts attempts per key in fixed windows so abusable
+// endpoints can be throttled per caller and per target account
+
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/neilZon/workout-logger-api/common"
+)
+
+// Rule allows Limit hits per Window
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Store keeps hit counts. Implementations must be safe for concurrent use.
+type Store interface {
+	// Hit records a hit against key and returns the number of hits in the
+	// current window along with when that window ends
+	Hit(ctx context.Context, key string, window time.Duration) (count int, resetAt time.Time, err error)
+}
+
+type Limiter struct {
+	store Store
+	now   func() time.Time
+}
+
+func NewLimiter(store Store) *Limiter {
+	return &Limiter{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Allow records a hit for key and returns a *common.RateLimitedError once
+// the rule's limit has been exceeded for the current window
+func (l *Limiter) Allow(ctx context.Context, key string, rule Rule) error {
+	count, resetAt, err := l.store.Hit(ctx, key, rule.Window)
+	if err != nil {
+		return err
+	}
+
+	if count > rule.Limit {
+		return &common.RateLimitedError{RetryAfter: resetAt.Sub(l.now())}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/neilZon/workout-logger-api/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (f *fakeClock) now() time.Time { return f.t }
+
+// helpers.SetupMockDB can't be used here since helpers imports this package
+func setupMockDB() (sqlmock.Sqlmock, *gorm.DB) {
+	mockDb, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: mockDb,
+	}), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+
+	return mock, gormDB
+}
+
+func TestRateLimit(t *testing.T) {
+	ctx := context.Background()
+	rule := Rule{Limit: 3, Window: time.Minute}
+
+	t.Run("Memory store blocks after the limit until the window resets", func(t *testing.T) {
+		clock := &fakeClock{t: time.Date(2022, time.October, 30, 12, 0, 0, 0, time.UTC)}
+		store := NewMemoryStore()
+		store.now = clock.now
+		limiter := NewLimiter(store)
+		limiter.now = clock.now
+
+		for i := 0; i < rule.Limit; i++ {
+			require.Nil(t, limiter.Allow(ctx, "login:ip:1.2.3.4", rule))
+		}
+
+		clock.t = clock.t.Add(20 * time.Second)
+		err := limiter.Allow(ctx, "login:ip:1.2.3.4", rule)
+		var rateLimitedError *common.RateLimitedError
+		require.True(t, errors.As(err, &rateLimitedError))
+		assert.Equal(t, 40*time.Second, rateLimitedError.RetryAfter)
+
+		// other keys are counted separately
+		assert.Nil(t, limiter.Allow(ctx, "login:ip:5.6.7.8", rule))
+
+		clock.t = clock.t.Add(time.Minute)
+		assert.Nil(t, limiter.Allow(ctx, "login:ip:1.2.3.4", rule))
+	})
+
+	t.Run("Memory store sweeps expired windows", func(t *testing.T) {
+		clock := &fakeClock{t: time.Date(2022, time.October, 30, 12, 0, 0, 0, time.UTC)}
+		store := NewMemoryStore()
+		store.now = clock.now
+
+		store.Hit(ctx, "a", time.Second)
+		store.Hit(ctx, "b", time.Hour)
+
+		clock.t = clock.t.Add(2 * sweepInterval)
+		store.Hit(ctx, "c", time.Second)
+
+		assert.Len(t, store.windows, 2)
+		assert.NotContains(t, store.windows, "a")
+	})
+
+	t.Run("Postgres store upserts the window", func(t *testing.T) {
+		mock, gormDB := setupMockDB()
+		now := time.Date(2022, time.October, 30, 12, 0, 0, 0, time.UTC)
+		store := NewPostgresStore(gormDB)
+		store.now = func() time.Time { return now }
+		resetAt := now.Add(30 * time.Second)
+
+		const hitQuery = `INSERT INTO rate_limits (key, count, reset_at) VALUES ($1, 1, $2)`
+		mock.ExpectQuery(regexp.QuoteMeta(hitQuery)).
+			WithArgs("signup:ip:1.2.3.4", now.Add(time.Minute), now, now).
+			WillReturnRows(sqlmock.NewRows([]string{"key", "count", "reset_at"}).AddRow("signup:ip:1.2.3.4", 4, resetAt))
+
+		count, gotResetAt, err := store.Hit(ctx, "signup:ip:1.2.3.4", time.Minute)
+		require.Nil(t, err)
+		assert.Equal(t, 4, count)
+		assert.Equal(t, resetAt, gotResetAt)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+}
@@ -51,9 +51,10 @@ func (w *WorkoutRoutineReader) GetWorkoutRoutines(ctx context.Context, keys data
 		workoutSessionId := strconv.Itoa(int(workoutSession.ID))
 		workoutRoutineId := strconv.Itoa(int(workoutSession.WorkoutRoutine.ID))
 		workoutRoutineById[workoutSessionId] = &model.WorkoutRoutine{
-			ID:     workoutRoutineId,
-			Name:   workoutSession.WorkoutRoutine.Name,
-			Active: workoutSession.WorkoutRoutine.Active,
+			ID:      workoutRoutineId,
+			Name:    workoutSession.WorkoutRoutine.Name,
+			Active:  workoutSession.WorkoutRoutine.Active,
+			Version: workoutSession.WorkoutRoutine.Version,
 		}
 	}
 
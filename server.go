@@ -2,11 +2,15 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"time"
+	// the alpine image has no zoneinfo for the timezones digests are sent in
+	_ "time/tzdata"
 
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -15,8 +19,17 @@ import (
 	"github.com/neilZon/workout-logger-api/config"
 	"github.com/neilZon/workout-logger-api/database"
 	db "github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/digest"
+	"github.com/neilZon/workout-logger-api/graph"
 	"github.com/neilZon/workout-logger-api/helpers"
+	"github.com/neilZon/workout-logger-api/mail"
 	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/oidc"
+	"github.com/neilZon/workout-logger-api/outbox"
+	"github.com/neilZon/workout-logger-api/password"
+	"github.com/neilZon/workout-logger-api/ratelimit"
+	"github.com/neilZon/workout-logger-api/token"
+	"github.com/neilZon/workout-logger-api/totp"
 	"github.com/rs/cors"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"gorm.io/gorm"
@@ -40,8 +53,59 @@ func main() {
 		log.Fatal(err)
 	}
 
+	keys, err := token.LoadKeys()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var rateLimitStore ratelimit.Store = ratelimit.NewPostgresStore(db)
+	if os.Getenv(config.RATE_LIMIT_STORE) == "memory" {
+		rateLimitStore = ratelimit.NewMemoryStore()
+	}
+	limiter := ratelimit.NewLimiter(rateLimitStore)
+
+	oidcProviders, err := oidc.LoadProviders()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	passwordPolicy, err := password.LoadPolicy()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	deletionGracePeriod := config.DELETION_GRACE_PERIOD
+	if v := os.Getenv(config.ACCOUNT_DELETION_GRACE); v != "" {
+		deletionGracePeriod, err = time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("%s: %v", config.ACCOUNT_DELETION_GRACE, err)
+		}
+	}
+	go purgeDeletedAccounts(db, config.DELETION_PURGE_INTERVAL)
+
+	transport, err := mail.LoadMailer()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// email is queued in the database and delivered through transport in the background
+	mailer := outbox.NewMailer(db)
+	go outbox.NewWorker(db, transport).Run(context.Background(), config.OUTBOX_POLL_INTERVAL)
+	go digest.NewScheduler(db, mailer).Run(context.Background(), config.DIGEST_POLL_INTERVAL)
+
 	acs := accesscontrol.NewAccessControllerService(db)
-	srv := helpers.NewGqlServer(db, acs)
+	srv := helpers.NewGqlServer(&graph.Resolver{
+		DB:      db,
+		ACS:     acs,
+		Keys:    keys,
+		Limiter: limiter,
+		Mailer:  mailer,
+		TOTP:    totp.New(config.TOTP_ISSUER),
+		OIDC:    oidc.NewVerifier(oidcProviders...),
+
+		PasswordPolicy:      passwordPolicy,
+		Hasher:              password.DefaultHasher(),
+		DeletionGracePeriod: deletionGracePeriod,
+	})
 	srv.Use(extension.Introspection{})
 	srv.SetRecoverFunc(func(ctx context.Context, err interface{}) error {
 		// notify bug tracker...maybe? idk too much money
@@ -61,10 +125,11 @@ func main() {
 	loaders := helpers.NewLoaders(db)
 
 	dataloaderMiddleware := middleware.DataloaderMiddleware(loaders, srv)
-	authMiddleware := middleware.AuthMiddleware(dataloaderMiddleware)
+	authMiddleware := middleware.AuthMiddleware(db, keys, dataloaderMiddleware)
+	clientMiddleware := middleware.ClientMiddleware(authMiddleware)
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", c.Handler(authMiddleware))
+	http.Handle("/query", c.Handler(clientMiddleware))
 
 	http.HandleFunc("/static/", func(w http.ResponseWriter, r *http.Request) {
 		// Open the file specified by the request path
@@ -90,16 +155,23 @@ func main() {
 	})
 
 	basehandler := &BaseHandler{
-		DB: db,
+		DB:     db,
+		Keys:   keys,
+		Mailer: mailer,
 	}
 	http.HandleFunc("/verify", basehandler.verify)
+	http.HandleFunc("/confirm-email", basehandler.confirmEmail)
+	http.HandleFunc("/unsubscribe", basehandler.unsubscribe)
+	http.HandleFunc("/.well-known/jwks.json", basehandler.jwks)
 
 	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
 	log.Fatal(http.ListenAndServe(":"+port, nil))
 }
 
 type BaseHandler struct {
-	DB *gorm.DB
+	DB     *gorm.DB
+	Keys   *token.Keys
+	Mailer mail.Mailer
 }
 
 func (b *BaseHandler) verify(w http.ResponseWriter, r *http.Request) {
@@ -138,3 +210,109 @@ func (b *BaseHandler) verify(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// swaps in a pending email once the link sent to the new address is opened
+func (b *BaseHandler) confirmEmail(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		host := os.Getenv(config.HOST)
+
+		code := r.URL.Query().Get("code")
+		if code == "" {
+			http.Redirect(w, r, fmt.Sprintf("%s/static/email-change-failure.html", host), http.StatusSeeOther)
+			return
+		}
+
+		user, err := database.ConfirmEmailChange(b.DB, code, time.Now().Add(-config.EMAIL_CHANGE_TTL))
+		if err != nil {
+			http.Redirect(w, r, fmt.Sprintf("%s/static/email-change-failure.html", host), http.StatusSeeOther)
+			return
+		}
+
+		// the change already happened, a failed notice shouldn't undo it
+		err = mail.SendEmailChangedNotice(b.Mailer, *user.PendingEmail, user.Email, user.Locale)
+		if err != nil {
+			log.Printf("could not send email changed notice to user %d: %v", user.ID, err)
+		}
+
+		http.Redirect(w, r, fmt.Sprintf("%s/static/email-change-success.html", host), http.StatusSeeOther)
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write([]byte("405 Method not allowed"))
+		return
+	}
+}
+
+// serves the public keys tokens are signed with so other services can verify them
+func (b *BaseHandler) jwks(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		json.NewEncoder(w).Encode(b.Keys.Access.JWKS())
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write([]byte("405 Method not allowed"))
+		return
+	}
+}
+
+// opts a user out of weekly digests from the link in one, no login needed.
+// Opening the link only asks to confirm so link scanners can't unsubscribe
+// anyone, mail clients post to it directly for one click unsubscribes.
+func (b *BaseHandler) unsubscribe(w http.ResponseWriter, r *http.Request) {
+	host := os.Getenv(config.HOST)
+
+	switch r.Method {
+	case "GET":
+		code := r.URL.Query().Get("code")
+		if code == "" {
+			http.Redirect(w, r, fmt.Sprintf("%s/static/unsubscribe-failure.html", host), http.StatusSeeOther)
+			return
+		}
+
+		http.Redirect(w, r, fmt.Sprintf("%s/static/unsubscribe.html?code=%s", host, url.QueryEscape(code)), http.StatusSeeOther)
+		return
+	case "POST":
+		// the confirmation page posts the code, mail clients post to the link with it
+		code := r.FormValue("code")
+		unsubscribed := code != "" && database.UnsubscribeDigest(b.DB, code) == nil
+
+		// RFC 8058 one click unsubscribes aren't shown a page
+		if r.PostFormValue("List-Unsubscribe") == "One-Click" {
+			if !unsubscribed {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		if !unsubscribed {
+			http.Redirect(w, r, fmt.Sprintf("%s/static/unsubscribe-failure.html", host), http.StatusSeeOther)
+			return
+		}
+		http.Redirect(w, r, fmt.Sprintf("%s/static/unsubscribe-success.html", host), http.StatusSeeOther)
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write([]byte("405 Method not allowed"))
+		return
+	}
+}
+
+// permanently deletes accounts once their deletion grace period has ended
+func purgeDeletedAccounts(db *gorm.DB, interval time.Duration) {
+	for range time.Tick(interval) {
+		purged, err := database.PurgeDeletedUsers(db, time.Now())
+		if err != nil {
+			log.Printf("could not purge deleted accounts: %v", err)
+			continue
+		}
+		if purged > 0 {
+			log.Printf("purged %d deleted accounts", purged)
+		}
+	}
+}
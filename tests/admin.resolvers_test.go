@@ -0,0 +1,178 @@
+package test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/joho/godotenv"
+	"github.com/neilZon/workout-logger-api/accesscontroller/accesscontrol"
+	"github.com/neilZon/workout-logger-api/database"
+	"github.com/neilZon/workout-logger-api/helpers"
+	"github.com/neilZon/workout-logger-api/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type AdminUsersResp struct {
+	AdminUsers struct {
+		Edges []struct {
+			Cursor string
+			Node   struct {
+				ID       string
+				Email    string
+				Role     string
+				Verified bool
+				Disabled bool
+			}
+		}
+		PageInfo struct {
+			HasNextPage bool
+		}
+	}
+}
+
+func TestAdminResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	const userQuery = `SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+	adminClaims := &token.Claims{ID: 1, SessionID: 3, Role: database.RoleAdmin}
+	expectAdmin := func(mock sqlmock.Sqlmock, role string) {
+		adminRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "verified", "role"}).
+			AddRow(1, "admin", "admin@test.com", true, role)
+		mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs("1").WillReturnRows(adminRow)
+	}
+
+	t.Run("Admin users searches by name or email", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		expectAdmin(mock, database.RoleAdmin)
+
+		userRows := sqlmock.
+			NewRows([]string{"id", "name", "email", "verified", "role", "disabled_at"}).
+			AddRow(5, "nat_b", "nat@test.com", false, "", nil).
+			AddRow(9, "nat_b", "natb@test.com", true, "", nil).
+			AddRow(12, "nat_b", "natbee@test.com", true, "", nil)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE (email ILIKE $1 OR name ILIKE $2) AND id > $3 AND "users"."deleted_at" IS NULL ORDER BY id LIMIT 3`)).
+			WithArgs(`%nat\_b%`, `%nat\_b%`, "2").
+			WillReturnRows(userRows)
+
+		var resp AdminUsersResp
+		c.MustPost(`query AdminUsers {
+			adminUsers(search: "nat_b", limit: 2, after: "2") {
+				edges {
+					cursor
+					node {
+						id
+						email
+						role
+						verified
+						disabled
+					}
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}`, &resp, helpers.AddContext(adminClaims, helpers.NewLoaders(gormDB)))
+
+		require.Len(t, resp.AdminUsers.Edges, 2)
+		assert.True(t, resp.AdminUsers.PageInfo.HasNextPage)
+		assert.Equal(t, "9", resp.AdminUsers.Edges[1].Cursor)
+		assert.Equal(t, "nat@test.com", resp.AdminUsers.Edges[0].Node.Email)
+		assert.Equal(t, "user", resp.AdminUsers.Edges[0].Node.Role)
+		assert.False(t, resp.AdminUsers.Edges[0].Node.Verified)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Admin resolvers reject users who aren't admins", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		var resp struct{}
+		err := c.Post(`mutation AdminVerifyUser {
+			adminVerifyUser(userId: "5") {
+				id
+			}
+		}`, &resp, helpers.AddContext(&token.Claims{ID: 1, SessionID: 3}, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Forbidden\",\"path\":[\"adminVerifyUser\"],\"extensions\":{\"code\":\"FORBIDDEN\"}}]")
+
+		// demoted since the token was issued
+		expectAdmin(mock, "")
+		err = c.Post(`mutation AdminVerifyUser {
+			adminVerifyUser(userId: "5") {
+				id
+			}
+		}`, &resp, helpers.AddContext(adminClaims, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Forbidden\",\"path\":[\"adminVerifyUser\"],\"extensions\":{\"code\":\"FORBIDDEN\"}}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Admin disable user signs them out everywhere", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		expectAdmin(mock, database.RoleAdmin)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "verified"}).
+			AddRow(5, "nat", "nat@test.com", true)
+		mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs("5").WillReturnRows(userRow)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "disabled_at"=$1,"updated_at"=$2 WHERE (id = $3 AND disabled_at IS NULL) AND "users"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 5).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "sessions" SET "revoked_at"=$1,"updated_at"=$2 WHERE (user_id = $3 AND revoked_at IS NULL)`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 5).
+			WillReturnResult(sqlmock.NewResult(1, 2))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "refresh_tokens" SET "revoked_at"=$1,"updated_at"=$2 WHERE (user_id = $3 AND revoked_at IS NULL)`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 5).
+			WillReturnResult(sqlmock.NewResult(1, 2))
+		mock.ExpectCommit()
+
+		disabledRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "verified", "disabled_at"}).
+			AddRow(5, "nat", "nat@test.com", true, time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs("5").WillReturnRows(disabledRow)
+
+		var resp struct {
+			AdminDisableUser struct {
+				ID       string
+				Disabled bool
+			}
+		}
+		c.MustPost(`mutation AdminDisableUser {
+			adminDisableUser(userId: "5") {
+				id
+				disabled
+			}
+		}`, &resp, helpers.AddContext(adminClaims, helpers.NewLoaders(gormDB)))
+		assert.Equal(t, "5", resp.AdminDisableUser.ID)
+		assert.True(t, resp.AdminDisableUser.Disabled)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,9 +16,15 @@ import (
 	"github.com/neilZon/workout-logger-api/graph"
 	"github.com/neilZon/workout-logger-api/graph/generated"
 	"github.com/neilZon/workout-logger-api/helpers"
+	"github.com/neilZon/workout-logger-api/mail"
+	"github.com/neilZon/workout-logger-api/middleware"
+	"github.com/neilZon/workout-logger-api/oidc"
+	"github.com/neilZon/workout-logger-api/outbox"
+	"github.com/neilZon/workout-logger-api/ratelimit"
 	"github.com/neilZon/workout-logger-api/token"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,17 +47,9 @@ type SignupResp struct {
 	}
 }
 
-func TestAuthResolvers(t *testing.T) {
-	t.Parallel()
-
-	err := godotenv.Load("../.env")
-	if err != nil {
-		panic("Error loading .env file")
-	}
-	ACCESS_SECRET := []byte(os.Getenv(config.ACCESS_SECRET))
-	REFRESH_SECRET := []byte(os.Getenv(config.REFRESH_SECRET))
-
-	u := database.User{
+// authUser is the account the auth suites sign in as, its password is password123
+func authUser() database.User {
+	return database.User{
 		Model: gorm.Model{
 			ID:        23,
 			CreatedAt: time.Now(),
@@ -64,6 +63,19 @@ func TestAuthResolvers(t *testing.T) {
 		Email:    "test@test.com",
 		Password: "$2a$10$0EGP2OywIngzJKu.GoKS8eG/08tGSbZi5sMbDoJ..nWVgvQQlaDcC",
 	}
+}
+
+func TestAuthResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+	ACCESS_SECRET := []byte(os.Getenv(config.ACCESS_SECRET))
+	REFRESH_SECRET := []byte(os.Getenv(config.REFRESH_SECRET))
+
+	u := authUser()
 
 	t.Run("Login resolver success", func(t *testing.T) {
 		mock, gormDB := helpers.SetupMockDB()
@@ -142,7 +154,7 @@ func TestAuthResolvers(t *testing.T) {
 
 		// empty response struct since we know we are going to return an error
 		var resp struct{}
-		err = c.Post(`mutation Login {
+		err := c.Post(`mutation Login {
 			login(loginInput: {
 			  email: "notexistingemail@test.com",
 			  password: "password123",
@@ -167,7 +179,7 @@ func TestAuthResolvers(t *testing.T) {
 
 		// empty response struct since we know we are going to return an error
 		var resp struct{}
-		err = c.Post(`mutation Login {
+		err := c.Post(`mutation Login {
 			login(loginInput: {
 			  email: "this_is_def_not_an_email_WTFFFFF",
 			  password: "password123",
@@ -331,7 +343,7 @@ func TestAuthResolvers(t *testing.T) {
 			}
 		  }`,
 			&resp)
-		require.EqualError(t, err, "[{\"message\":\"Password needs at least 1 number and 8 - 32 characters\",\"path\":[\"signup\"]}]")
+		require.EqualError(t, err, "[{\"message\":\"password needs at least 1 number\",\"path\":[\"signup\"]}]")
 
 		err = mock.ExpectationsWereMet() // make sure all expectations were met
 		if err != nil {
@@ -358,7 +370,7 @@ func TestAuthResolvers(t *testing.T) {
 			}
 		  }`,
 			&resp)
-		require.EqualError(t, err, "[{\"message\":\"Password needs at least 1 number and 8 - 32 characters\",\"path\":[\"signup\"]}]")
+		require.EqualError(t, err, "[{\"message\":\"password needs to be between 8 and 128 characters\",\"path\":[\"signup\"]}]")
 
 		err = mock.ExpectationsWereMet() // make sure all expectations were met
 		if err != nil {
@@ -397,3 +409,1324 @@ func TestAuthResolvers(t *testing.T) {
 		c.MustPost(refreshAccessTokenMutation, &resp)
 	})
 }
+
+func TestSessionResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+	REFRESH_SECRET := []byte(os.Getenv(config.REFRESH_SECRET))
+
+	u := authUser()
+
+	t.Run("Refresh resolver revokes session on reuse", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		cred := &token.Credentials{
+			ID:      u.ID,
+			Name:    u.Name,
+			Email:   u.Email,
+			TokenID: "rotatedtokenid",
+		}
+		refreshToken := token.Sign(cred, REFRESH_SECRET, 5)
+
+		rotatedAt := time.Now().Add(-time.Minute)
+		refreshTokenRow := sqlmock.
+			NewRows([]string{"id", "token_id", "session_id", "expires_at", "rotated_at", "revoked_at", "user_id"}).
+			AddRow(1, cred.TokenID, 7, time.Now().Add(time.Hour), rotatedAt, nil, u.ID)
+		const refreshTokenQuery = `SELECT * FROM "refresh_tokens" WHERE token_id = $1 AND "refresh_tokens"."deleted_at" IS NULL ORDER BY "refresh_tokens"."id" LIMIT 1`
+		mock.ExpectQuery(regexp.QuoteMeta(refreshTokenQuery)).WithArgs(cred.TokenID).WillReturnRows(refreshTokenRow)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true)
+		const userQuery = `SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+		mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs(fmt.Sprintf("%d", u.ID)).WillReturnRows(userRow)
+
+		// token was already rotated so nothing gets updated
+		mock.ExpectBegin()
+		const rotateQuery = `UPDATE "refresh_tokens" SET "rotated_at"=$1,"updated_at"=$2 WHERE (id = $3 AND rotated_at IS NULL AND revoked_at IS NULL) AND "refresh_tokens"."deleted_at" IS NULL`
+		mock.ExpectExec(regexp.QuoteMeta(rotateQuery)).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		mock.ExpectBegin()
+		const revokeSessionQuery = `UPDATE "sessions" SET "revoked_at"=$1,"updated_at"=$2 WHERE (id = $3 AND revoked_at IS NULL) AND "sessions"."deleted_at" IS NULL`
+		mock.ExpectExec(regexp.QuoteMeta(revokeSessionQuery)).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 7).WillReturnResult(sqlmock.NewResult(0, 1))
+		const revokeRefreshTokensQuery = `UPDATE "refresh_tokens" SET "revoked_at"=$1,"updated_at"=$2 WHERE (session_id = $3 AND revoked_at IS NULL) AND "refresh_tokens"."deleted_at" IS NULL`
+		mock.ExpectExec(regexp.QuoteMeta(revokeRefreshTokensQuery)).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 7).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		var resp struct{}
+		err := c.Post(fmt.Sprintf(`mutation RefreshAccessToken {
+			refreshAccessToken(refreshToken: "Bearer %s") {
+				accessToken
+				refreshToken
+			}
+		}`, refreshToken), &resp)
+		require.EqualError(t, err, "[{\"message\":\"Refresh token invalid\",\"path\":[\"refreshAccessToken\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Sessions lists the signed in user's devices", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		claims := &token.Claims{ID: u.ID, SessionID: 7}
+		helpers.ExpectVerified(mock, claims)
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(userRow)
+		sessionRows := sqlmock.
+			NewRows([]string{"id", "device_name", "ip", "last_used_at", "user_id"}).
+			AddRow(7, "phone", "203.0.113.7", time.Now(), u.ID).
+			AddRow(8, "laptop", "203.0.113.8", time.Now().Add(-time.Hour), u.ID)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "sessions" WHERE (user_id = $1 AND revoked_at IS NULL) AND "sessions"."deleted_at" IS NULL ORDER BY last_used_at desc`)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(sessionRows)
+
+		var resp struct {
+			User struct {
+				Sessions []struct {
+					ID      string
+					IP      string
+					Current bool
+				}
+			}
+		}
+		c.MustPost(`query User {
+			user {
+				sessions {
+					id
+					ip
+					current
+				}
+			}
+		}`, &resp, helpers.AddContext(claims, helpers.NewLoaders(gormDB)))
+		require.Len(t, resp.User.Sessions, 2)
+		assert.Equal(t, "203.0.113.7", resp.User.Sessions[0].IP)
+		assert.True(t, resp.User.Sessions[0].Current)
+		assert.False(t, resp.User.Sessions[1].Current)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Sessions can't be listed with an api token", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		apiTokenClaims := &token.Claims{ID: u.ID, ApiTokenID: 4, Scope: "user:read"}
+		helpers.ExpectVerified(mock, apiTokenClaims)
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(userRow)
+
+		var resp struct{}
+		err := c.Post(`query User {
+			user {
+				sessions {
+					ip
+				}
+			}
+		}`, &resp, helpers.AddContext(apiTokenClaims, helpers.NewLoaders(gormDB)))
+		require.ErrorContains(t, err, `"message":"Forbidden","path":["user","sessions"]`)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestLoginResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+	ACCESS_SECRET := []byte(os.Getenv(config.ACCESS_SECRET))
+	REFRESH_SECRET := []byte(os.Getenv(config.REFRESH_SECRET))
+
+	u := authUser()
+	const userByEmailQuery = `SELECT * FROM "users" WHERE email = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+	const userByIdQuery = `SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+	loginWith := func(password string) string {
+		return fmt.Sprintf(`mutation Login {
+			login(loginInput: { email: "%s", password: "%s" }) {
+				accessToken
+				refreshToken
+			}
+		}`, u.Email, password)
+	}
+
+	t.Run("Login resolver success resets failed attempts and upgrades the hash", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified", "failed_login_attempts"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true, 2)
+		mock.ExpectQuery(regexp.QuoteMeta(userByEmailQuery)).WithArgs(u.Email).WillReturnRows(userRow)
+		mock.ExpectQuery(regexp.QuoteMeta(userByIdQuery)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "verified"}).AddRow(u.ID, true))
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "failed_login_attempts"=$1,"locked_until"=$2,"updated_at"=$3 WHERE id = $4 AND "users"."deleted_at" IS NULL`)).
+			WithArgs(0, nil, sqlmock.AnyArg(), fmt.Sprintf("%d", u.ID)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		// the bcrypt hash is upgraded to the current algorithm
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "password"=$1,"updated_at"=$2 WHERE (id = $3 AND password = $4) AND "users"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), u.ID, u.Password).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "sessions"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "refresh_tokens"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		var resp LoginResp
+		c.MustPost(loginWith("password123"), &resp)
+		assert.True(t, token.Validate(resp.Login.AccessToken, ACCESS_SECRET))
+		assert.True(t, token.Validate(resp.Login.RefreshToken, REFRESH_SECRET))
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login resolver wrong password counts towards a lockout", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified", "failed_login_attempts"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true, config.MAX_FAILED_LOGINS-1)
+		mock.ExpectQuery(regexp.QuoteMeta(userByEmailQuery)).WithArgs(u.Email).WillReturnRows(userRow)
+		mock.ExpectQuery(regexp.QuoteMeta(userByIdQuery)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "verified"}).AddRow(u.ID, true))
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "failed_login_attempts"=CASE WHEN failed_login_attempts + 1 >= $1 THEN 0 ELSE failed_login_attempts + 1 END,"locked_until"=CASE WHEN failed_login_attempts + 1 >= $2 THEN $3 ELSE locked_until END,"updated_at"=$4 WHERE id = $5 AND "users"."deleted_at" IS NULL`)).
+			WithArgs(config.MAX_FAILED_LOGINS, config.MAX_FAILED_LOGINS, sqlmock.AnyArg(), sqlmock.AnyArg(), fmt.Sprintf("%d", u.ID)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		var resp struct{}
+		err := c.Post(loginWith("wrongpassword123"), &resp)
+		require.EqualError(t, err, "[{\"message\":\"Incorrect Password\",\"path\":[\"login\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login resolver rejects locked accounts even with the right password", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified", "locked_until"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true, time.Now().Add(10*time.Minute))
+		mock.ExpectQuery(regexp.QuoteMeta(userByEmailQuery)).WithArgs(u.Email).WillReturnRows(userRow)
+		mock.ExpectQuery(regexp.QuoteMeta(userByIdQuery)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "verified"}).AddRow(u.ID, true))
+
+		var resp struct{}
+		err := c.Post(loginWith("password123"), &resp)
+		require.ErrorContains(t, err, `"code":"RATE_LIMITED"`)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login resolver rate limited per email", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		const userQuery = `SELECT * FROM "users" WHERE email = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+		loginMutation := `mutation Login {
+			login(loginInput: {
+			  email: "notexistingemail@test.com",
+			  password: "password123",
+			}) {
+				refreshToken,
+				accessToken
+			  }
+		  }`
+
+		var resp struct{}
+		for i := 0; i < 10; i++ {
+			mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs("notexistingemail@test.com").WillReturnError(gorm.ErrRecordNotFound)
+			err := c.Post(loginMutation, &resp)
+			require.EqualError(t, err, "[{\"message\":\"Email does not exist\",\"path\":[\"login\"]}]")
+		}
+
+		err := c.Post(loginMutation, &resp)
+		require.ErrorContains(t, err, `"code":"RATE_LIMITED"`)
+		require.ErrorContains(t, err, `"retryAfter":900`)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login resolver rate limited per ip despite forged X-Forwarded-For", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		keys, err := token.LoadKeys()
+		require.Nil(t, err)
+		c := client.New(middleware.ClientMiddleware(helpers.NewGqlServer(&graph.Resolver{
+			DB:      gormDB,
+			Keys:    keys,
+			Limiter: ratelimit.NewLimiter(ratelimit.NewMemoryStore()),
+		})))
+
+		const userQuery = `SELECT * FROM "users" WHERE email = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+		login := func(i int, lbHop string) error {
+			var resp struct{}
+			// the caller picks everything left of the hop the load balancer appends
+			forged := client.AddHeader("X-Forwarded-For", fmt.Sprintf("10.0.0.%d, 198.51.100.%d", i, i))
+			appended := client.AddHeader("X-Forwarded-For", lbHop)
+			return c.Post(fmt.Sprintf(`mutation Login {
+				login(loginInput: {
+				  email: "lifter%d@test.com",
+				  password: "password123",
+				}) {
+					accessToken
+				}
+			}`, i), &resp, forged, appended)
+		}
+
+		// a new email each time so only the per ip limit applies
+		for i := 0; i < 30; i++ {
+			mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs(fmt.Sprintf("lifter%d@test.com", i)).WillReturnError(gorm.ErrRecordNotFound)
+			err := login(i, "203.0.113.7")
+			require.EqualError(t, err, "[{\"message\":\"Email does not exist\",\"path\":[\"login\"]}]")
+		}
+
+		err = login(30, "203.0.113.7")
+		require.ErrorContains(t, err, `"code":"RATE_LIMITED"`)
+
+		// another caller has a bucket of its own
+		mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs("lifter31@test.com").WillReturnError(gorm.ErrRecordNotFound)
+		err = login(31, "203.0.113.8")
+		require.EqualError(t, err, "[{\"message\":\"Email does not exist\",\"path\":[\"login\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestOidcResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+	ACCESS_SECRET := []byte(os.Getenv(config.ACCESS_SECRET))
+	REFRESH_SECRET := []byte(os.Getenv(config.REFRESH_SECRET))
+
+	u := authUser()
+	const identityQuery = `SELECT users.* FROM "users" JOIN identities ON identities.user_id = users.id AND identities.deleted_at IS NULL WHERE (identities.provider = $1 AND identities.subject = $2) AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+	const nonceQuery = `DELETE FROM "oidc_nonces" WHERE nonce = $1 AND expires_at > $2`
+	loginWithOidc := func(idToken string) string {
+		return fmt.Sprintf(`mutation LoginWithOidc {
+			loginWithOidc(provider: "google", idToken: "%s", nonce: "nonce-1") {
+				accessToken
+				refreshToken
+			}
+		}`, idToken)
+	}
+
+	t.Run("Oidc nonce is stored until it is used", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "oidc_nonces" ("nonce","expires_at") VALUES ($1,$2)`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			OidcNonce string
+		}
+		c.MustPost(`mutation OidcNonce { oidcNonce }`, &resp)
+		assert.NotEmpty(t, resp.OidcNonce)
+
+		err := mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login with oidc signs in a linked identity", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		keys, err := token.LoadKeys()
+		require.Nil(t, err)
+
+		issuer := helpers.NewTestIssuer()
+		defer issuer.Close()
+		c := client.New(helpers.NewGqlServer(&graph.Resolver{
+			DB:      gormDB,
+			Keys:    keys,
+			Limiter: ratelimit.NewLimiter(ratelimit.NewMemoryStore()),
+			OIDC:    oidc.NewVerifier(&oidc.Provider{Name: "google", Issuer: issuer.URL, ClientIDs: []string{"client-1"}}),
+		}))
+
+		idToken := issuer.Sign(jwt.MapClaims{
+			"iss":            issuer.URL,
+			"aud":            "client-1",
+			"sub":            "google-1234",
+			"email":          u.Email,
+			"email_verified": true,
+			"nonce":          "nonce-1",
+			"exp":            time.Now().Add(time.Hour).Unix(),
+		})
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(nonceQuery)).
+			WithArgs("nonce-1", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true)
+		mock.ExpectQuery(regexp.QuoteMeta(identityQuery)).WithArgs("google", "google-1234").WillReturnRows(userRow)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "sessions"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "refresh_tokens"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			LoginWithOidc struct {
+				AccessToken  string
+				RefreshToken string
+			}
+		}
+		c.MustPost(loginWithOidc(idToken), &resp)
+		assert.True(t, token.Validate(resp.LoginWithOidc.AccessToken, ACCESS_SECRET))
+		assert.True(t, token.Validate(resp.LoginWithOidc.RefreshToken, REFRESH_SECRET))
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login with oidc rejects tokens for another client", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		issuer := helpers.NewTestIssuer()
+		defer issuer.Close()
+		c := client.New(helpers.NewGqlServer(&graph.Resolver{
+			DB:   gormDB,
+			OIDC: oidc.NewVerifier(&oidc.Provider{Name: "google", Issuer: issuer.URL, ClientIDs: []string{"client-1"}}),
+		}))
+
+		idToken := issuer.Sign(jwt.MapClaims{
+			"iss":   issuer.URL,
+			"aud":   "someone-elses-app",
+			"sub":   "google-1234",
+			"email": u.Email,
+			"nonce": "nonce-1",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		})
+
+		var resp struct{}
+		err := c.Post(loginWithOidc(idToken), &resp)
+		require.EqualError(t, err, "[{\"message\":\"Id token invalid\",\"path\":[\"loginWithOidc\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login with oidc rejects a replayed token", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		issuer := helpers.NewTestIssuer()
+		defer issuer.Close()
+		c := client.New(helpers.NewGqlServer(&graph.Resolver{
+			DB:   gormDB,
+			OIDC: oidc.NewVerifier(&oidc.Provider{Name: "google", Issuer: issuer.URL, ClientIDs: []string{"client-1"}}),
+		}))
+
+		idToken := issuer.Sign(jwt.MapClaims{
+			"iss":   issuer.URL,
+			"aud":   "client-1",
+			"sub":   "google-1234",
+			"email": u.Email,
+			"nonce": "nonce-1",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		})
+
+		// the nonce was deleted when the token was first used
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(nonceQuery)).
+			WithArgs("nonce-1", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		var resp struct{}
+		err := c.Post(loginWithOidc(idToken), &resp)
+		require.EqualError(t, err, "[{\"message\":\"Id token invalid\",\"path\":[\"loginWithOidc\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login with oidc creates the user and queues the verification email together", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		keys, err := token.LoadKeys()
+		require.Nil(t, err)
+
+		issuer := helpers.NewTestIssuer()
+		defer issuer.Close()
+		c := client.New(helpers.NewGqlServer(&graph.Resolver{
+			DB:      gormDB,
+			Keys:    keys,
+			Limiter: ratelimit.NewLimiter(ratelimit.NewMemoryStore()),
+			Mailer:  outbox.NewMailer(gormDB),
+			OIDC:    oidc.NewVerifier(&oidc.Provider{Name: "google", Issuer: issuer.URL, ClientIDs: []string{"client-1"}}),
+		}))
+
+		// unverified so the user has to confirm the email themselves
+		idToken := issuer.Sign(jwt.MapClaims{
+			"iss":   issuer.URL,
+			"aud":   "client-1",
+			"sub":   "google-1234",
+			"email": u.Email,
+			"nonce": "nonce-1",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		})
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(nonceQuery)).
+			WithArgs("nonce-1", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(regexp.QuoteMeta(identityQuery)).WithArgs("google", "google-1234").WillReturnRows(sqlmock.NewRows([]string{}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1`)).WithArgs(u.Email).WillReturnRows(sqlmock.NewRows([]string{}))
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(u.ID))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "identities"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox_emails"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "sessions"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "refresh_tokens"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			LoginWithOidc struct {
+				AccessToken  string
+				RefreshToken string
+			}
+		}
+		c.MustPost(loginWithOidc(idToken), &resp)
+		assert.True(t, token.Validate(resp.LoginWithOidc.AccessToken, ACCESS_SECRET))
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login with oidc doesn't create the user when the email can't be queued", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+
+		issuer := helpers.NewTestIssuer()
+		defer issuer.Close()
+		c := client.New(helpers.NewGqlServer(&graph.Resolver{
+			DB:     gormDB,
+			Mailer: outbox.NewMailer(gormDB),
+			OIDC:   oidc.NewVerifier(&oidc.Provider{Name: "google", Issuer: issuer.URL, ClientIDs: []string{"client-1"}}),
+		}))
+
+		idToken := issuer.Sign(jwt.MapClaims{
+			"iss":   issuer.URL,
+			"aud":   "client-1",
+			"sub":   "google-1234",
+			"email": u.Email,
+			"nonce": "nonce-1",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		})
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(nonceQuery)).
+			WithArgs("nonce-1", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(regexp.QuoteMeta(identityQuery)).WithArgs("google", "google-1234").WillReturnRows(sqlmock.NewRows([]string{}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1`)).WithArgs(u.Email).WillReturnRows(sqlmock.NewRows([]string{}))
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(u.ID))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "identities"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox_emails"`)).WillReturnError(fmt.Errorf("outbox unavailable"))
+		mock.ExpectRollback()
+
+		var resp struct{}
+		err := c.Post(loginWithOidc(idToken), &resp)
+		require.EqualError(t, err, "[{\"message\":\"Error Logging In\",\"path\":[\"loginWithOidc\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestLoginLinkResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+	ACCESS_SECRET := []byte(os.Getenv(config.ACCESS_SECRET))
+	REFRESH_SECRET := []byte(os.Getenv(config.REFRESH_SECRET))
+
+	u := authUser()
+
+	t.Run("Login with link issues tokens", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true)
+		const consumeQuery = `UPDATE "users" SET "login_link_code"=$1,"login_link_sent_at"=$2,"verification_code"=$3,"verified"=$4,"updated_at"=$5 WHERE (login_link_code = $6 AND login_link_sent_at > $7) AND "users"."deleted_at" IS NULL RETURNING *`
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(consumeQuery)).
+			WithArgs(nil, nil, nil, true, sqlmock.AnyArg(), "logincode", sqlmock.AnyArg()).
+			WillReturnRows(userRow)
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "sessions"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "refresh_tokens"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			LoginWithLink struct {
+				AccessToken  string
+				RefreshToken string
+			}
+		}
+		c.MustPost(`mutation LoginWithLink {
+			loginWithLink(code: "logincode") {
+				accessToken
+				refreshToken
+			}
+		}`, &resp)
+		assert.True(t, token.Validate(resp.LoginWithLink.AccessToken, ACCESS_SECRET))
+		assert.True(t, token.Validate(resp.LoginWithLink.RefreshToken, REFRESH_SECRET))
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login with link rejects used or expired codes", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "users" SET`)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+
+		var resp struct{}
+		err := c.Post(`mutation LoginWithLink {
+			loginWithLink(code: "usedcode") {
+				accessToken
+			}
+		}`, &resp)
+		require.EqualError(t, err, "[{\"message\":\"Login link invalid or expired\",\"path\":[\"loginWithLink\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestChangePasswordResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := authUser()
+
+	t.Run("Change password keeps only the current session", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		const userQuery = `SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+		for i := 0; i < 2; i++ {
+			userRow := sqlmock.
+				NewRows([]string{"id", "name", "email", "password", "verified"}).
+				AddRow(u.ID, u.Name, u.Email, u.Password, true)
+			mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs(fmt.Sprintf("%d", u.ID)).WillReturnRows(userRow)
+		}
+
+		// the old bcrypt hash is upgraded once the current password checks out
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "password"=$1,"updated_at"=$2 WHERE (id = $3 AND password = $4)`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), u.ID, u.Password).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "password"=$1,"password_reset_code"=$2,"password_reset_sent_at"=$3,"updated_at"=$4 WHERE id = $5`)).
+			WithArgs(sqlmock.AnyArg(), nil, nil, sqlmock.AnyArg(), u.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "sessions" SET "revoked_at"=$1,"updated_at"=$2 WHERE (user_id = $3 AND revoked_at IS NULL) AND id <> $4`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), u.ID, 7).
+			WillReturnResult(sqlmock.NewResult(1, 2))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "refresh_tokens" SET "revoked_at"=$1,"updated_at"=$2 WHERE (user_id = $3 AND revoked_at IS NULL) AND session_id <> $4`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), u.ID, 7).
+			WillReturnResult(sqlmock.NewResult(1, 2))
+		mock.ExpectCommit()
+
+		var resp struct {
+			ChangePassword bool
+		}
+		c.MustPost(`mutation ChangePassword {
+			changePassword(currentPassword: "password123", newPassword: "newpassword123")
+		}`, &resp, helpers.AddContext(&token.Claims{ID: u.ID, SessionID: 7}, helpers.NewLoaders(gormDB)))
+		assert.True(t, resp.ChangePassword)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Change password wrong current password", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		const userQuery = `SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+		for i := 0; i < 2; i++ {
+			userRow := sqlmock.
+				NewRows([]string{"id", "name", "email", "password", "verified"}).
+				AddRow(u.ID, u.Name, u.Email, u.Password, true)
+			mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs(fmt.Sprintf("%d", u.ID)).WillReturnRows(userRow)
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "failed_login_attempts"=`)).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		var resp struct{}
+		err := c.Post(`mutation ChangePassword {
+			changePassword(currentPassword: "wrongpassword1", newPassword: "newpassword123")
+		}`, &resp, helpers.AddContext(&token.Claims{ID: u.ID, SessionID: 7}, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Incorrect Password\",\"path\":[\"changePassword\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestApiTokenResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := authUser()
+
+	t.Run("Create api token returns it once and stores only a hash", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(userRow)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "api_tokens" ("created_at","updated_at","deleted_at","name","token_hash","prefix","scopes","expires_at","last_used_at","revoked_at","user_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "export script", sqlmock.AnyArg(), sqlmock.AnyArg(), "sessions:read routines:read", nil, nil, nil, u.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		mock.ExpectCommit()
+
+		var resp struct {
+			CreateApiToken struct {
+				Token    string
+				ApiToken struct {
+					ID     string
+					Prefix string
+					Scopes []string
+				}
+			}
+		}
+		c.MustPost(`mutation CreateApiToken {
+			createApiToken(name: "export script", scopes: ["sessions:read", "routines:read", "sessions:read"]) {
+				token
+				apiToken {
+					id
+					prefix
+					scopes
+				}
+			}
+		}`, &resp, helpers.AddContext(&token.Claims{ID: u.ID, SessionID: 7}, helpers.NewLoaders(gormDB)))
+		assert.Equal(t, "4", resp.CreateApiToken.ApiToken.ID)
+		assert.True(t, strings.HasPrefix(resp.CreateApiToken.Token, token.ApiTokenPrefix))
+		assert.True(t, strings.HasPrefix(resp.CreateApiToken.Token, resp.CreateApiToken.ApiToken.Prefix))
+		assert.Equal(t, []string{"sessions:read", "routines:read"}, resp.CreateApiToken.ApiToken.Scopes)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Create api token rejects unknown scopes", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(userRow)
+
+		var resp struct{}
+		err := c.Post(`mutation CreateApiToken {
+			createApiToken(name: "export script", scopes: ["admin"]) {
+				token
+			}
+		}`, &resp, helpers.AddContext(&token.Claims{ID: u.ID, SessionID: 7}, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Unknown scope \\\"admin\\\"\",\"path\":[\"createApiToken\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Api token limited to its scopes", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		apiTokenClaims := &token.Claims{ID: u.ID, ApiTokenID: 4, Scope: "sessions:read"}
+
+		var resp struct{}
+		err := c.Post(`mutation DeleteWorkoutSession {
+			deleteWorkoutSession(workoutSessionId: "1")
+		}`, &resp, helpers.AddContext(apiTokenClaims, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Forbidden\",\"path\":[\"deleteWorkoutSession\"],\"extensions\":{\"code\":\"FORBIDDEN\"}}]")
+
+		// account management needs a login
+		err = c.Post(`mutation ChangePassword {
+			changePassword(currentPassword: "password123", newPassword: "newpassword123")
+		}`, &resp, helpers.AddContext(apiTokenClaims, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Forbidden\",\"path\":[\"changePassword\"],\"extensions\":{\"code\":\"FORBIDDEN\"}}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestVerifiedDirective(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := authUser()
+
+	t.Run("Verified check runs once per request", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		// one lookup for @verified and one for each user field
+		const userQuery = `SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+		for i := 0; i < 3; i++ {
+			userRow := sqlmock.
+				NewRows([]string{"id", "name", "email", "password", "verified"}).
+				AddRow(u.ID, u.Name, u.Email, u.Password, true)
+			mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs(fmt.Sprintf("%d", u.ID)).WillReturnRows(userRow)
+		}
+
+		var resp struct {
+			First  struct{ Email string }
+			Second struct{ Email string }
+		}
+		c.MustPost(`query User {
+			first: user {
+				email
+			}
+			second: user {
+				email
+			}
+		}`, &resp, helpers.AddContext(&token.Claims{ID: u.ID, SessionID: 7}, helpers.NewLoaders(gormDB)))
+		assert.Equal(t, u.Email, resp.First.Email)
+		assert.Equal(t, u.Email, resp.Second.Email)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Verified directive rejects unverified users", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, false)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(userRow)
+
+		var resp struct{}
+		err := c.Post(`mutation EnableTotp {
+			enableTotp {
+				secret
+			}
+		}`, &resp, helpers.AddContext(&token.Claims{ID: u.ID, SessionID: 7}, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"user not verified\",\"path\":[\"enableTotp\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestAccountDeletionResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := authUser()
+	const userByIdQuery = `SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+	expectScheduleDeletion := func(mock sqlmock.Sqlmock) {
+		// the verified check and the resolver both load the user
+		for i := 0; i < 2; i++ {
+			userRow := sqlmock.
+				NewRows([]string{"id", "name", "email", "password", "verified"}).
+				AddRow(u.ID, u.Name, u.Email, u.Password, true)
+			mock.ExpectQuery(regexp.QuoteMeta(userByIdQuery)).WithArgs(fmt.Sprintf("%d", u.ID)).WillReturnRows(userRow)
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "delete_after"=$1,"deletion_cancel_code"=$2,"updated_at"=$3 WHERE id = $4`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), u.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "sessions" SET "revoked_at"=$1,"updated_at"=$2 WHERE (user_id = $3 AND revoked_at IS NULL)`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "refresh_tokens" SET "revoked_at"=$1,"updated_at"=$2 WHERE (user_id = $3 AND revoked_at IS NULL)`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+
+	t.Run("Restore account cancels a scheduled deletion", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "users" SET "delete_after"=$1,"deletion_cancel_code"=$2,"updated_at"=$3 WHERE (deletion_cancel_code = $4 AND delete_after > $5) AND "users"."deleted_at" IS NULL RETURNING *`)).
+			WithArgs(nil, nil, sqlmock.AnyArg(), "cancelcode", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(u.ID, u.Email))
+		mock.ExpectCommit()
+
+		var resp struct {
+			RestoreAccount bool
+		}
+		c.MustPost(`mutation RestoreAccount {
+			restoreAccount(code: "cancelcode")
+		}`, &resp)
+		assert.True(t, resp.RestoreAccount)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Restore account rejects expired codes", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		// the purge could run any time after delete_after so the code stops working then
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "users" SET "delete_after"=$1,"deletion_cancel_code"=$2,"updated_at"=$3 WHERE (deletion_cancel_code = $4 AND delete_after > $5) AND "users"."deleted_at" IS NULL RETURNING *`)).
+			WithArgs(nil, nil, sqlmock.AnyArg(), "cancelcode", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email"}))
+		mock.ExpectCommit()
+
+		var resp struct{}
+		err := c.Post(`mutation RestoreAccount {
+			restoreAccount(code: "cancelcode")
+		}`, &resp)
+		require.EqualError(t, err, "[{\"message\":\"Restore link invalid or expired\",\"path\":[\"restoreAccount\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Login rejects accounts scheduled for deletion", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified", "delete_after"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true, time.Now().Add(time.Hour))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(u.Email).
+			WillReturnRows(userRow)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "verified"}).AddRow(u.ID, true))
+
+		mock.MatchExpectationsInOrder(false)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users"`)).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		var resp struct{}
+		err := c.Post(`mutation Login {
+			login(loginInput: { email: "`+u.Email+`", password: "password123" }) {
+				accessToken
+			}
+		}`, &resp)
+		require.EqualError(t, err, "[{\"message\":\"Account Scheduled For Deletion\",\"path\":[\"login\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Delete user schedules the deletion and queues the notice together", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClientWithMailer(gormDB, acs, outbox.NewMailer(gormDB))
+
+		expectScheduleDeletion(mock)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox_emails"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			DeleteUser int
+		}
+		c.MustPost(`mutation DeleteUser {
+			deleteUser
+		}`, &resp, helpers.AddContext(&token.Claims{ID: u.ID}, helpers.NewLoaders(gormDB)))
+		assert.Equal(t, 1, resp.DeleteUser)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Delete user isn't scheduled when the notice can't be queued", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClientWithMailer(gormDB, acs, outbox.NewMailer(gormDB))
+
+		expectScheduleDeletion(mock)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox_emails"`)).WillReturnError(fmt.Errorf("outbox unavailable"))
+		mock.ExpectRollback()
+
+		var resp struct{}
+		err := c.Post(`mutation DeleteUser {
+			deleteUser
+		}`, &resp, helpers.AddContext(&token.Claims{ID: u.ID}, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Error Deleting User\",\"path\":[\"deleteUser\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestVerificationEmailStatusResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := authUser()
+
+	t.Run("Verification email status is the signed in user's", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, false)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(userRow)
+		sentAt := time.Now()
+		emailRow := sqlmock.
+			NewRows([]string{"id", "kind", "recipient", "status", "attempts", "sent_at"}).
+			AddRow(3, mail.KindVerification, u.Email, database.OutboxSent, 1, sentAt)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox_emails" WHERE (recipient = $1 AND kind = $2) AND "outbox_emails"."deleted_at" IS NULL ORDER BY id desc,"outbox_emails"."id" LIMIT 1`)).
+			WithArgs(u.Email, mail.KindVerification).
+			WillReturnRows(emailRow)
+
+		var resp struct {
+			VerificationEmailStatus struct {
+				Status   string
+				Attempts int
+			}
+		}
+		c.MustPost(`query VerificationEmailStatus {
+			verificationEmailStatus {
+				status
+				attempts
+			}
+		}`, &resp, helpers.AddContext(&token.Claims{ID: u.ID, SessionID: 7}, helpers.NewLoaders(gormDB)))
+		assert.Equal(t, "SENT", resp.VerificationEmailStatus.Status)
+		assert.Equal(t, 1, resp.VerificationEmailStatus.Attempts)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Verification email status needs a signed in user", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		var resp struct{}
+		err := c.Post(`query VerificationEmailStatus {
+			verificationEmailStatus {
+				status
+			}
+		}`, &resp)
+		require.EqualError(t, err, `[{"message":"Unauthorized","path":["verificationEmailStatus"],"extensions":{"code":"UNAUTHORIZED"}}]`)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestResendVerificationCodeResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := authUser()
+
+	t.Run("Resend verification code emails the user in their locale", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		recorder := mail.NewRecorder()
+		c := helpers.NewGqlClientWithMailer(gormDB, acs, recorder)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "locale"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, "es-MX")
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(u.Email).
+			WillReturnRows(userRow)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "verification_code"=$1,"verification_pin"=$2,"verification_pin_attempts"=$3,"verification_sent_at"=$4,"updated_at"=$5 WHERE email = $6`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 0, sqlmock.AnyArg(), sqlmock.AnyArg(), u.Email).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			ResendVerificationCode bool
+		}
+		c.MustPost(fmt.Sprintf(`mutation Resend {
+			resendVerificationCode(email: "%s")
+		}`, u.Email), &resp)
+		assert.True(t, resp.ResendVerificationCode)
+
+		require.Len(t, recorder.Messages(), 1)
+		msg := recorder.Messages()[0]
+		assert.Equal(t, []string{u.Email}, msg.To)
+		assert.Equal(t, "¡Verificación de correo!", msg.Subject)
+		assert.Contains(t, msg.HTML, "Verificar correo")
+		assert.Contains(t, msg.Text, "/verify?code=")
+		assert.Regexp(t, `caduca en 30 minutos: \d{6}\n`, msg.Text)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestVerifyEmailResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := authUser()
+
+	t.Run("Verify email accepts the emailed code", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "email", "verification_pin", "verification_pin_attempts", "verification_sent_at"}).
+			AddRow(u.ID, u.Email, "042917", 1, time.Now().Add(-time.Minute))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "users" SET "verification_pin_attempts"=verification_pin_attempts + 1,"updated_at"=$1 WHERE (email = $2 AND NOT verified AND verification_pin IS NOT NULL) AND "users"."deleted_at" IS NULL RETURNING *`)).
+			WithArgs(sqlmock.AnyArg(), u.Email).
+			WillReturnRows(userRow)
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "verification_code"=$1,"verification_pin"=$2,"verification_pin_attempts"=$3,"verification_sent_at"=$4,"verified"=$5,"updated_at"=$6 WHERE id = $7`)).
+			WithArgs(nil, nil, 0, nil, true, sqlmock.AnyArg(), u.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			VerifyEmail bool
+		}
+		c.MustPost(fmt.Sprintf(`mutation VerifyEmail {
+			verifyEmail(email: "%s", code: "042917")
+		}`, u.Email), &resp)
+		assert.True(t, resp.VerifyEmail)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Verify email rejects expired or overused codes", func(t *testing.T) {
+		rows := map[string]*sqlmock.Rows{
+			"wrong code": sqlmock.NewRows([]string{"id", "email", "verification_pin", "verification_pin_attempts", "verification_sent_at"}).
+				AddRow(u.ID, u.Email, "042917", 1, time.Now()),
+			"expired": sqlmock.NewRows([]string{"id", "email", "verification_pin", "verification_pin_attempts", "verification_sent_at"}).
+				AddRow(u.ID, u.Email, "111111", 1, time.Now().Add(-config.VERIFICATION_PIN_TTL-time.Minute)),
+			"too many attempts": sqlmock.NewRows([]string{"id", "email", "verification_pin", "verification_pin_attempts", "verification_sent_at"}).
+				AddRow(u.ID, u.Email, "111111", config.MAX_VERIFICATION_PIN_ATTEMPTS+1, time.Now()),
+			"already verified": sqlmock.NewRows([]string{"id"}),
+		}
+
+		for name, row := range rows {
+			mock, gormDB := helpers.SetupMockDB()
+			acs := accesscontrol.NewAccessControllerService(gormDB)
+			c := helpers.NewGqlClient(gormDB, acs)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "users" SET "verification_pin_attempts"=verification_pin_attempts + 1`)).
+				WillReturnRows(row)
+			mock.ExpectCommit()
+
+			var resp struct{}
+			err := c.Post(fmt.Sprintf(`mutation VerifyEmail {
+				verifyEmail(email: "%s", code: "111111")
+			}`, u.Email), &resp)
+			require.EqualError(t, err, "[{\"message\":\"Verification code invalid or expired\",\"path\":[\"verifyEmail\"]}]", name)
+
+			err = mock.ExpectationsWereMet() // make sure all expectations were met
+			if err != nil {
+				panic(err)
+			}
+		}
+	})
+}
@@ -3,6 +3,7 @@ package test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -62,12 +63,16 @@ func TestExerciseRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		mock.ExpectBegin()
-		createExerciseRoutineStmt := `INSERT INTO "exercise_routines" ("created_at","updated_at","deleted_at","name","sets","reps","active","workout_routine_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`
+		const nextPositionQuery = `SELECT COALESCE(MAX(position) + 1, 0) FROM "exercise_routines" WHERE workout_routine_id = $1 AND "exercise_routines"."deleted_at" IS NULL`
+		mock.ExpectQuery(regexp.QuoteMeta(nextPositionQuery)).
+			WithArgs(er.WorkoutRoutineID).
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(2))
+		createExerciseRoutineStmt := `INSERT INTO "exercise_routines" ("created_at","updated_at","deleted_at","name","sets","reps","active","position","workout_routine_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`
 		mock.ExpectQuery(regexp.QuoteMeta(createExerciseRoutineStmt)).
-			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), er.Name, er.Sets, er.Reps, er.Active, er.WorkoutRoutineID).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), er.Name, er.Sets, er.Reps, er.Active, 2, er.WorkoutRoutineID).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(er.ID))
 		mock.ExpectCommit()
 
@@ -122,7 +127,7 @@ func TestExerciseRoutineResolvers(t *testing.T) {
 		acs := accesscontrol.NewAccessControllerService(gormDB)
 		c := helpers.NewGqlClient(gormDB, acs)
 
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnError(gorm.ErrRecordNotFound)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnError(gorm.ErrRecordNotFound)
 
 		var resp AddExerciseRoutine
 		mutation := fmt.Sprintf(`
@@ -155,7 +160,7 @@ func TestExerciseRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		exerciseRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "sets", "reps", "created_at", "deleted_at", "updated_at"}).
@@ -190,7 +195,7 @@ func TestExerciseRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, incorrectUserId, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		var resp GetExerciseRoutineResp
 		query := fmt.Sprintf(`query ExerciseRoutines {
@@ -215,7 +220,7 @@ func TestExerciseRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		const exerciseRoutineQuery = `SELECT * FROM "exercise_routines" WHERE workout_routine_id = $1 AND "exercise_routines"."deleted_at" IS NULL`
 		mock.ExpectQuery(regexp.QuoteMeta(exerciseRoutineQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnError(gorm.ErrInvalidTransaction)
@@ -244,38 +249,32 @@ func TestExerciseRoutineResolvers(t *testing.T) {
 		acs := accesscontrol.NewAccessControllerService(gormDB)
 		c := helpers.NewGqlClient(gormDB, acs)
 
+		helpers.ExpectVerified(mock, u)
+		accessRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(er.ID, u.ID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.ExerciseRoutineAccessQuery)).WithArgs(er.ID).WillReturnRows(accessRow)
+
 		exerciseRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "sets", "reps", "created_at", "deleted_at", "updated_at", "workout_routine_id"}).
 			AddRow(er.ID, er.Name, er.Sets, er.Reps, er.CreatedAt, er.DeletedAt, er.UpdatedAt, er.WorkoutRoutineID)
 		const exerciseRoutineQuery = `SELECT * FROM "exercise_routines" WHERE id = $1 AND "exercise_routines"."deleted_at" IS NULL ORDER BY "exercise_routines"."id" LIMIT 1`
 		mock.ExpectQuery(regexp.QuoteMeta(exerciseRoutineQuery)).WithArgs(fmt.Sprintf("%d", er.ID)).WillReturnRows(exerciseRoutineRow)
 
-		workoutRoutineRow := sqlmock.
-			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
-			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
-
 		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "workout_routines" WHERE id = $1 AND "workout_routines"."deleted_at" IS NULL ORDER BY "workout_routines"."id" LIMIT 1 FOR UPDATE`)).
+			WithArgs(wr.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "active", "version"}).AddRow(wr.ID, wr.Name, true, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "workout_routine_versions" WHERE (workout_routine_id = $1 AND version = $2) AND "workout_routine_versions"."deleted_at" IS NULL`)).
+			WithArgs(wr.ID, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 		deleteExerciseRoutineQuery := `UPDATE "exercise_routines" SET "deleted_at"=$1 WHERE id = $2 AND "exercise_routines"."deleted_at" IS NULL`
 		mock.ExpectExec(regexp.QuoteMeta(deleteExerciseRoutineQuery)).
 			WithArgs(sqlmock.AnyArg(), utils.UIntToString(er.ID)).
 			WillReturnResult(sqlmock.NewResult(1, 1))
-
-		exerciseRow := sqlmock.
-			NewRows([]string{"id", "created_at", "deleted_at", "updated_at", "workout_session_id", "exercise_routine_id"})
-		for _, e := range ws.Exercises {
-			exerciseRow.AddRow(e.ID, e.CreatedAt, e.DeletedAt, e.UpdatedAt, e.WorkoutSessionID, e.ExerciseRoutineID)
-		}
-		deleteExerciseQuery := `UPDATE "exercises" SET "deleted_at"=$1 WHERE exercise_routine_id = $2 AND "exercises"."deleted_at" IS NULL RETURNING *`
-		mock.ExpectQuery(regexp.QuoteMeta(deleteExerciseQuery)).
-			WithArgs(sqlmock.AnyArg(), utils.UIntToString(er.ID)).
-			WillReturnRows(exerciseRow)
-
-		deleteSetQuery := `UPDATE "set_entries" SET "deleted_at"=$1 WHERE exercise_id IN ($2,$3) AND "set_entries"."deleted_at" IS NULL`
-		mock.ExpectExec(regexp.QuoteMeta(deleteSetQuery)).
-			WithArgs(sqlmock.AnyArg(), utils.UIntToString(ws.Exercises[0].ID), utils.UIntToString(ws.Exercises[1].ID)).
-			WillReturnResult(sqlmock.NewResult(1, 2))
-		mock.ExpectCommit()
+		// past exercises and their sets are kept
+		remaining := wr.ExerciseRoutines[1]
+		helpers.ExpectWorkoutRoutineChangeSave(mock, wr.ID, wr.Name, 2, sqlmock.
+			NewRows([]string{"id", "name", "sets", "reps", "workout_routine_id"}).
+			AddRow(remaining.ID, remaining.Name, remaining.Sets, remaining.Reps, wr.ID))
 
 		var resp DeleteExerciseRoutineResp
 		gqlQuery := fmt.Sprintf(`
@@ -328,7 +327,7 @@ func TestExerciseRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, incorrectUserId, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		var resp DeleteExerciseRoutineResp
 		gqlQuery := fmt.Sprintf(`
@@ -360,7 +359,7 @@ func TestExerciseRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		mock.ExpectBegin()
 		deleteExerciseRoutineQuery := `UPDATE "exercise_routines" SET "deleted_at"=$1 WHERE id = $2 AND "exercise_routines"."deleted_at" IS NULL`
@@ -395,3 +394,229 @@ func TestExerciseRoutineResolvers(t *testing.T) {
 		}
 	})
 }
+
+func TestReorderExerciseRoutineResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := testdata.User
+	wr := testdata.WorkoutRoutine
+	const workoutRoutineExerciseRoutinesQuery = `SELECT * FROM "exercise_routines" WHERE workout_routine_id = $1 AND "exercise_routines"."deleted_at" IS NULL`
+
+	t.Run("Reorder Exercise Routines", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.
+			NewRows([]string{"id", "user_id"}).
+			AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		first, second := wr.ExerciseRoutines[0], wr.ExerciseRoutines[1]
+		exerciseRoutineRows := sqlmock.
+			NewRows([]string{"id", "name", "sets", "reps", "active", "position", "workout_routine_id"}).
+			AddRow(first.ID, first.Name, first.Sets, first.Reps, true, 0, wr.ID).
+			AddRow(second.ID, second.Name, second.Sets, second.Reps, true, 1, wr.ID)
+		helpers.ExpectWorkoutRoutineChangeStart(mock, wr.ID, wr.Name, 2)
+		mock.ExpectQuery(regexp.QuoteMeta(workoutRoutineExerciseRoutinesQuery)).
+			WithArgs(utils.UIntToString(wr.ID)).
+			WillReturnRows(exerciseRoutineRows)
+		const updatePositionStmt = `UPDATE "exercise_routines" SET "position"=$1,"updated_at"=$2 WHERE "exercise_routines"."deleted_at" IS NULL AND "id" = $3`
+		mock.ExpectExec(regexp.QuoteMeta(updatePositionStmt)).
+			WithArgs(0, sqlmock.AnyArg(), second.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(updatePositionStmt)).
+			WithArgs(1, sqlmock.AnyArg(), first.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		reorderedRows := sqlmock.
+			NewRows([]string{"id", "name", "sets", "reps", "position", "workout_routine_id"}).
+			AddRow(second.ID, second.Name, second.Sets, second.Reps, 0, wr.ID).
+			AddRow(first.ID, first.Name, first.Sets, first.Reps, 1, wr.ID)
+		helpers.ExpectWorkoutRoutineChangeSave(mock, wr.ID, wr.Name, 3, reorderedRows)
+
+		var resp struct {
+			ReorderExerciseRoutines []struct {
+				ID   string
+				Name string
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation ReorderExerciseRoutines {
+				reorderExerciseRoutines(workoutRoutineId: "%d", exerciseRoutineIds: ["%d", "%d"]) {
+					id
+					name
+				}
+			}`,
+			wr.ID, second.ID, first.ID,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Len(t, resp.ReorderExerciseRoutines, 2)
+		require.Equal(t, utils.UIntToString(second.ID), resp.ReorderExerciseRoutines[0].ID)
+		require.Equal(t, utils.UIntToString(first.ID), resp.ReorderExerciseRoutines[1].ID)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Reorder Exercise Routines Must List Every Exercise Routine Once", func(t *testing.T) {
+		first := wr.ExerciseRoutines[0]
+		idLists := [][]uint{
+			{first.ID},
+			{first.ID, first.ID},
+			{first.ID, 999},
+		}
+
+		for _, ids := range idLists {
+			mock, gormDB := helpers.SetupMockDB()
+			acs := accesscontrol.NewAccessControllerService(gormDB)
+			c := helpers.NewGqlClient(gormDB, acs)
+
+			helpers.ExpectVerified(mock, u)
+			workoutRoutineRow := sqlmock.
+				NewRows([]string{"id", "user_id"}).
+				AddRow(wr.ID, wr.UserID)
+			mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+			exerciseRoutineRows := sqlmock.NewRows([]string{"id", "position", "workout_routine_id"})
+			for i, er := range wr.ExerciseRoutines {
+				exerciseRoutineRows.AddRow(er.ID, i, wr.ID)
+			}
+			helpers.ExpectWorkoutRoutineChangeStart(mock, wr.ID, wr.Name, 2)
+			mock.ExpectQuery(regexp.QuoteMeta(workoutRoutineExerciseRoutinesQuery)).
+				WithArgs(utils.UIntToString(wr.ID)).
+				WillReturnRows(exerciseRoutineRows)
+			mock.ExpectRollback()
+
+			quoted := make([]string, 0, len(ids))
+			for _, id := range ids {
+				quoted = append(quoted, fmt.Sprintf("%q", utils.UIntToString(id)))
+			}
+			mutation := fmt.Sprintf(`
+				mutation ReorderExerciseRoutines {
+					reorderExerciseRoutines(workoutRoutineId: "%d", exerciseRoutineIds: [%s]) {
+						id
+					}
+				}`,
+				wr.ID, strings.Join(quoted, ", "),
+			)
+			var resp struct{}
+			err := c.Post(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+			require.EqualError(t, err, "[{\"message\":\"Exercise routine ids must be every exercise routine in the workout routine once\",\"path\":[\"reorderExerciseRoutines\"]}]", ids)
+
+			err = mock.ExpectationsWereMet()
+			if err != nil {
+				panic(err)
+			}
+		}
+	})
+}
+
+func TestArchiveExerciseRoutineResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := testdata.User
+	wr := testdata.WorkoutRoutine
+
+	t.Run("Archive Exercise Routine", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		er := wr.ExerciseRoutines[0]
+		helpers.ExpectVerified(mock, u)
+		exerciseRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(er.ID, u.ID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.ExerciseRoutineAccessQuery)).WithArgs(er.ID).WillReturnRows(exerciseRoutineRow)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "exercise_routines" SET "active"=$1,"updated_at"=$2 WHERE id = $3 AND "exercise_routines"."deleted_at" IS NULL RETURNING *`)).
+			WithArgs(false, sqlmock.AnyArg(), utils.UIntToString(er.ID)).
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "name", "sets", "reps", "active", "workout_routine_id"}).
+				AddRow(er.ID, er.Name, er.Sets, er.Reps, false, wr.ID))
+		mock.ExpectCommit()
+
+		var resp struct {
+			ArchiveExerciseRoutine struct {
+				ID     string
+				Name   string
+				Active bool
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation ArchiveExerciseRoutine {
+				archiveExerciseRoutine(exerciseRoutineId: "%d") {
+					id
+					name
+					active
+				}
+			}`,
+			er.ID,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Equal(t, er.Name, resp.ArchiveExerciseRoutine.Name)
+		require.False(t, resp.ArchiveExerciseRoutine.Active)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Get Exercise Routines Filtered By Active", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		er := wr.ExerciseRoutines[1]
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exercise_routines" WHERE workout_routine_id = $1 AND active = $2 AND "exercise_routines"."deleted_at" IS NULL ORDER BY position, id`)).
+			WithArgs(utils.UIntToString(wr.ID), true).
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "name", "sets", "reps", "active", "workout_routine_id"}).
+				AddRow(er.ID, er.Name, er.Sets, er.Reps, true, wr.ID))
+
+		var resp struct {
+			ExerciseRoutines []struct {
+				ID     string
+				Active bool
+			}
+		}
+		query := fmt.Sprintf(`
+			query ExerciseRoutines {
+				exerciseRoutines(workoutRoutineId: "%d", active: true) {
+					id
+					active
+				}
+			}`,
+			wr.ID,
+		)
+		c.MustPost(query, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Len(t, resp.ExerciseRoutines, 1)
+		require.Equal(t, utils.UIntToString(er.ID), resp.ExerciseRoutines[0].ID)
+		require.True(t, resp.ExerciseRoutines[0].Active)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+}
@@ -0,0 +1,489 @@
+package test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/joho/godotenv"
+	"github.com/neilZon/workout-logger-api/accesscontroller/accesscontrol"
+	"github.com/neilZon/workout-logger-api/helpers"
+	"github.com/neilZon/workout-logger-api/tests/testdata"
+	"github.com/neilZon/workout-logger-api/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutineLibraryResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := testdata.User
+	wr := testdata.WorkoutRoutine
+	squat, legExtensions := wr.ExerciseRoutines[0], wr.ExerciseRoutines[1]
+
+	const publishedId = 7
+	publishedExerciseRoutineRows := func() *sqlmock.Rows {
+		return sqlmock.
+			NewRows([]string{"id", "published_routine_id", "name", "sets", "reps", "position"}).
+			AddRow(1, publishedId, squat.Name, squat.Sets, squat.Reps, 0).
+			AddRow(2, publishedId, legExtensions.Name, legExtensions.Sets, legExtensions.Reps, 1)
+	}
+	const publishedExerciseRoutinesQuery = `SELECT * FROM "published_exercise_routines" WHERE "published_exercise_routines"."published_routine_id" = $1 AND "published_exercise_routines"."deleted_at" IS NULL ORDER BY position, id`
+	const publishedTagsQuery = `SELECT * FROM "published_routine_tags" WHERE "published_routine_tags"."published_routine_id" = $1 ORDER BY tag`
+
+	t.Run("Publish routine", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "workout_routines" WHERE id = $1 AND "workout_routines"."deleted_at" IS NULL ORDER BY "workout_routines"."id" LIMIT 1`)).
+			WithArgs(utils.UIntToString(wr.ID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "user_id"}).AddRow(wr.ID, wr.Name, u.ID))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exercise_routines" WHERE "exercise_routines"."workout_routine_id" = $1 AND "exercise_routines"."deleted_at" IS NULL ORDER BY position, id`)).
+			WithArgs(wr.ID).
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "name", "sets", "reps", "position", "workout_routine_id"}).
+				AddRow(squat.ID, squat.Name, squat.Sets, squat.Reps, 0, wr.ID).
+				AddRow(legExtensions.ID, legExtensions.Name, legExtensions.Sets, legExtensions.Reps, 1, wr.ID))
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "published_routines" ("created_at","updated_at","deleted_at","name","curated","search_text","adoptions","rating_count","rating_total","workout_routine_id","user_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, wr.Name, false, fmt.Sprintf("%s %s %s", wr.Name, squat.Name, legExtensions.Name), 0, 0, 0, wr.ID, u.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(publishedId))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "published_exercise_routines"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "published_routine_tags" ("published_routine_id","tag") VALUES ($1,$2),($3,$4) ON CONFLICT ("published_routine_id","tag") DO UPDATE SET "published_routine_id"="excluded"."published_routine_id"`)).
+			WithArgs(uint(publishedId), "legs", uint(publishedId), "strength").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		var resp struct {
+			PublishRoutine struct {
+				ID               string
+				Name             string
+				Tags             []string
+				Curated          bool
+				ExerciseRoutines []struct {
+					Name string
+				}
+				AverageRating *float64
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation PublishRoutine {
+				publishRoutine(workoutRoutineId: "%d", tags: [" Legs", "strength", "legs"]) {
+					id
+					name
+					tags
+					curated
+					exerciseRoutines {
+						name
+					}
+					averageRating
+				}
+			}`,
+			wr.ID,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Equal(t, utils.UIntToString(publishedId), resp.PublishRoutine.ID)
+		require.Equal(t, []string{"legs", "strength"}, resp.PublishRoutine.Tags)
+		require.False(t, resp.PublishRoutine.Curated)
+		require.Len(t, resp.PublishRoutine.ExerciseRoutines, 2)
+		require.Nil(t, resp.PublishRoutine.AverageRating)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Routine library searches names and filters by tags", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "published_routines" WHERE to_tsvector('english', search_text) @@ plainto_tsquery('english', $1) AND id IN (SELECT published_routine_id FROM published_routine_tags WHERE tag IN ($2) GROUP BY published_routine_id HAVING COUNT(*) = $3) AND id > $4 AND "published_routines"."deleted_at" IS NULL ORDER BY id LIMIT 2`)).
+			WithArgs("squat", "legs", 1, "3").
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "name", "curated", "adoptions", "rating_count", "rating_total"}).
+				AddRow(publishedId, wr.Name, true, 12, 2, 9).
+				AddRow(publishedId+1, "Leg Day", false, 0, 0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "published_exercise_routines" WHERE "published_exercise_routines"."published_routine_id" IN ($1,$2) AND "published_exercise_routines"."deleted_at" IS NULL ORDER BY position, id`)).
+			WithArgs(publishedId, publishedId+1).
+			WillReturnRows(publishedExerciseRoutineRows())
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "published_routine_tags" WHERE "published_routine_tags"."published_routine_id" IN ($1,$2) ORDER BY tag`)).
+			WithArgs(publishedId, publishedId+1).
+			WillReturnRows(sqlmock.NewRows([]string{"published_routine_id", "tag"}).AddRow(publishedId, "legs"))
+
+		var resp struct {
+			RoutineLibrary struct {
+				Edges []struct {
+					Cursor string
+					Node   struct {
+						Name             string
+						Tags             []string
+						Curated          bool
+						Adoptions        int
+						RatingCount      int
+						AverageRating    float64
+						ExerciseRoutines []struct {
+							Name string
+						}
+					}
+				}
+				PageInfo struct {
+					HasNextPage bool
+				}
+			}
+		}
+		c.MustPost(`
+			query RoutineLibrary {
+				routineLibrary(search: "squat", tags: ["Legs"], first: 1, after: "3") {
+					edges {
+						cursor
+						node {
+							name
+							tags
+							curated
+							adoptions
+							ratingCount
+							averageRating
+							exerciseRoutines {
+								name
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+					}
+				}
+			}`, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Len(t, resp.RoutineLibrary.Edges, 1)
+		require.True(t, resp.RoutineLibrary.PageInfo.HasNextPage)
+		edge := resp.RoutineLibrary.Edges[0]
+		require.Equal(t, utils.UIntToString(publishedId), edge.Cursor)
+		require.Equal(t, []string{"legs"}, edge.Node.Tags)
+		require.True(t, edge.Node.Curated)
+		require.Equal(t, 12, edge.Node.Adoptions)
+		require.Equal(t, 2, edge.Node.RatingCount)
+		require.Equal(t, 4.5, edge.Node.AverageRating)
+		require.Equal(t, squat.Name, edge.Node.ExerciseRoutines[0].Name)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Routine library filters by curated", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "published_routines" WHERE curated = $1 AND "published_routines"."deleted_at" IS NULL ORDER BY id LIMIT 11`)).
+			WithArgs(true).
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "name", "curated"}).
+				AddRow(publishedId, wr.Name, true))
+		mock.ExpectQuery(regexp.QuoteMeta(publishedExerciseRoutinesQuery)).
+			WithArgs(publishedId).
+			WillReturnRows(publishedExerciseRoutineRows())
+		mock.ExpectQuery(regexp.QuoteMeta(publishedTagsQuery)).
+			WithArgs(publishedId).
+			WillReturnRows(sqlmock.NewRows([]string{"published_routine_id", "tag"}))
+
+		var resp struct {
+			RoutineLibrary struct {
+				Edges []struct {
+					Node struct {
+						Curated bool
+					}
+				}
+			}
+		}
+		c.MustPost(`
+			query RoutineLibrary {
+				routineLibrary(curated: true, first: 10) {
+					edges {
+						node {
+							curated
+						}
+					}
+				}
+			}`, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Len(t, resp.RoutineLibrary.Edges, 1)
+		require.True(t, resp.RoutineLibrary.Edges[0].Node.Curated)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Rate routine recounts its ratings", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "published_routines" WHERE id = $1 AND "published_routines"."deleted_at" IS NULL ORDER BY "published_routines"."id" LIMIT 1 FOR UPDATE`)).
+			WithArgs(utils.UIntToString(publishedId)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(publishedId, wr.Name))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "routine_ratings" ("created_at","updated_at","deleted_at","published_routine_id","user_id","rating") VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT ("published_routine_id","user_id") DO UPDATE SET "rating"="excluded"."rating","updated_at"="excluded"."updated_at" RETURNING "id"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(publishedId), u.ID, 4).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "published_routines" SET "rating_count"=(SELECT COUNT(*) FROM routine_ratings WHERE published_routine_id = published_routines.id AND deleted_at IS NULL),"rating_total"=(SELECT COALESCE(SUM(rating), 0) FROM routine_ratings WHERE published_routine_id = published_routines.id AND deleted_at IS NULL),"updated_at"=$1 WHERE id = $2 AND "published_routines"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), uint(publishedId)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "published_routines" WHERE "published_routines"."id" = $1 AND "published_routines"."deleted_at" IS NULL`)).
+			WithArgs(publishedId, publishedId).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "rating_count", "rating_total"}).AddRow(publishedId, wr.Name, 3, 10))
+		mock.ExpectQuery(regexp.QuoteMeta(publishedExerciseRoutinesQuery)).
+			WithArgs(publishedId).
+			WillReturnRows(publishedExerciseRoutineRows())
+		mock.ExpectQuery(regexp.QuoteMeta(publishedTagsQuery)).
+			WithArgs(publishedId).
+			WillReturnRows(sqlmock.NewRows([]string{"published_routine_id", "tag"}))
+		mock.ExpectCommit()
+
+		var resp struct {
+			RateRoutine struct {
+				RatingCount   int
+				AverageRating float64
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation RateRoutine {
+				rateRoutine(publishedRoutineId: "%d", rating: 4) {
+					ratingCount
+					averageRating
+				}
+			}`,
+			publishedId,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Equal(t, 3, resp.RateRoutine.RatingCount)
+		require.InDelta(t, 3.33, resp.RateRoutine.AverageRating, 0.01)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Rate routine rejects rating your own routine", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "published_routines" WHERE id = $1 AND "published_routines"."deleted_at" IS NULL ORDER BY "published_routines"."id" LIMIT 1 FOR UPDATE`)).
+			WithArgs(utils.UIntToString(publishedId)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "user_id"}).AddRow(publishedId, wr.Name, u.ID))
+		mock.ExpectRollback()
+
+		var resp struct{}
+		mutation := fmt.Sprintf(`
+			mutation RateRoutine {
+				rateRoutine(publishedRoutineId: "%d", rating: 5) {
+					ratingCount
+				}
+			}`,
+			publishedId,
+		)
+		err := c.Post(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Error Rating Routine: Can't Rate Your Own Routine\",\"path\":[\"rateRoutine\"]}]")
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Rate routine rejects ratings outside 1 to 5", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+
+		var resp struct{}
+		err := c.Post(`
+			mutation RateRoutine {
+				rateRoutine(publishedRoutineId: "7", rating: 6) {
+					ratingCount
+				}
+			}`, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Error Rating Routine: rating needs to be between 1 to 5\",\"path\":[\"rateRoutine\"]}]")
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	const adoptionInsert = `INSERT INTO "routine_adoptions" ("created_at","updated_at","deleted_at","published_routine_id","user_id") VALUES ($1,$2,$3,$4,$5) ON CONFLICT DO NOTHING RETURNING "id"`
+	const adoptedId = 90
+	expectAdoptedClone := func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec("SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routines" ("created_at","updated_at","deleted_at","name","active","version","user_id") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, wr.Name, true, 1, u.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "active"}).AddRow(adoptedId, true))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercise_routines"`)).
+			WithArgs(
+				sqlmock.AnyArg(), sqlmock.AnyArg(), nil, squat.Name, squat.Sets, squat.Reps, true, 0, uint(adoptedId),
+				sqlmock.AnyArg(), sqlmock.AnyArg(), nil, legExtensions.Name, legExtensions.Sets, legExtensions.Reps, true, 1, uint(adoptedId),
+			).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(91).AddRow(92))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exercise_routines" WHERE workout_routine_id = $1`)).
+			WithArgs(utils.UIntToString(adoptedId)).
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "name", "sets", "reps", "position", "workout_routine_id"}).
+				AddRow(91, squat.Name, squat.Sets, squat.Reps, 0, adoptedId).
+				AddRow(92, legExtensions.Name, legExtensions.Sets, legExtensions.Reps, 1, adoptedId))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routine_versions"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(adoptedId), 1, wr.Name).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercise_routine_versions"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	}
+
+	t.Run("Adopt routine copies it into the caller's workout routines", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "published_routines" WHERE id = $1 AND "published_routines"."deleted_at" IS NULL ORDER BY "published_routines"."id" LIMIT 1`)).
+			WithArgs(utils.UIntToString(publishedId)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "adoptions"}).AddRow(publishedId, wr.Name, 12))
+		mock.ExpectQuery(regexp.QuoteMeta(publishedExerciseRoutinesQuery)).
+			WithArgs(publishedId).
+			WillReturnRows(publishedExerciseRoutineRows())
+		mock.ExpectQuery(regexp.QuoteMeta(publishedTagsQuery)).
+			WithArgs(publishedId).
+			WillReturnRows(sqlmock.NewRows([]string{"published_routine_id", "tag"}))
+		mock.ExpectQuery(regexp.QuoteMeta(adoptionInsert)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(publishedId), u.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "published_routines" SET "adoptions"=adoptions + 1 WHERE id = $1 AND "published_routines"."deleted_at" IS NULL`)).
+			WithArgs(uint(publishedId)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectAdoptedClone(mock)
+		mock.ExpectCommit()
+
+		var resp struct {
+			AdoptRoutine struct {
+				ID   string
+				Name string
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation AdoptRoutine {
+				adoptRoutine(publishedRoutineId: "%d") {
+					id
+					name
+				}
+			}`,
+			publishedId,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Equal(t, utils.UIntToString(adoptedId), resp.AdoptRoutine.ID)
+		require.Equal(t, wr.Name, resp.AdoptRoutine.Name)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Adopting a routine again doesn't count it again", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "published_routines" WHERE id = $1 AND "published_routines"."deleted_at" IS NULL ORDER BY "published_routines"."id" LIMIT 1`)).
+			WithArgs(utils.UIntToString(publishedId)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "adoptions"}).AddRow(publishedId, wr.Name, 12))
+		mock.ExpectQuery(regexp.QuoteMeta(publishedExerciseRoutinesQuery)).
+			WithArgs(publishedId).
+			WillReturnRows(publishedExerciseRoutineRows())
+		mock.ExpectQuery(regexp.QuoteMeta(publishedTagsQuery)).
+			WithArgs(publishedId).
+			WillReturnRows(sqlmock.NewRows([]string{"published_routine_id", "tag"}))
+		// the user already adopted it so nothing is inserted
+		mock.ExpectQuery(regexp.QuoteMeta(adoptionInsert)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(publishedId), u.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		expectAdoptedClone(mock)
+		mock.ExpectCommit()
+
+		var resp struct {
+			AdoptRoutine struct {
+				ID string
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation AdoptRoutine {
+				adoptRoutine(publishedRoutineId: "%d") {
+					id
+				}
+			}`,
+			publishedId,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Equal(t, utils.UIntToString(adoptedId), resp.AdoptRoutine.ID)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Unpublish routine only removes the caller's routines", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "published_routines" SET "deleted_at"=$1 WHERE user_id = $2 AND id = $3 AND "published_routines"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), u.ID, "8").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		var resp struct{}
+		err := c.Post(`
+			mutation UnpublishRoutine {
+				unpublishRoutine(publishedRoutineId: "8")
+			}`, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Error Unpublishing Routine: Not Found\",\"path\":[\"unpublishRoutine\"]}]")
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+package test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/joho/godotenv"
+	"github.com/neilZon/workout-logger-api/accesscontroller/accesscontrol"
+	"github.com/neilZon/workout-logger-api/helpers"
+	"github.com/neilZon/workout-logger-api/tests/testdata"
+	"github.com/neilZon/workout-logger-api/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutineShareResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	u := testdata.User
+	wr := testdata.WorkoutRoutine
+	squat, legExtensions := wr.ExerciseRoutines[0], wr.ExerciseRoutines[1]
+
+	const sharedRoutineQuery = `SELECT "workout_routines"."id","workout_routines"."created_at","workout_routines"."updated_at","workout_routines"."deleted_at","workout_routines"."name","workout_routines"."active","workout_routines"."version","workout_routines"."user_id" FROM "workout_routines" JOIN routine_share_codes ON routine_share_codes.workout_routine_id = workout_routines.id AND routine_share_codes.deleted_at IS NULL WHERE (routine_share_codes.code = $1 AND routine_share_codes.revoked_at IS NULL) AND "workout_routines"."deleted_at" IS NULL ORDER BY "workout_routines"."id" LIMIT 1`
+	const sharedExerciseRoutinesQuery = `SELECT * FROM "exercise_routines" WHERE active = $1 AND "exercise_routines"."workout_routine_id" = $2 AND "exercise_routines"."deleted_at" IS NULL ORDER BY position, id`
+	expectSharedRoutine := func(mock sqlmock.Sqlmock, code string) {
+		mock.ExpectQuery(regexp.QuoteMeta(sharedRoutineQuery)).
+			WithArgs(code).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "active", "version", "user_id"}).AddRow(wr.ID, wr.Name, true, 4, wr.UserID))
+		mock.ExpectQuery(regexp.QuoteMeta(sharedExerciseRoutinesQuery)).
+			WithArgs(true, wr.ID).
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "name", "sets", "reps", "position", "workout_routine_id"}).
+				AddRow(squat.ID, squat.Name, squat.Sets, squat.Reps, 0, wr.ID).
+				AddRow(legExtensions.ID, legExtensions.Name, legExtensions.Sets, legExtensions.Reps, 1, wr.ID))
+	}
+
+	t.Run("Create share code", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "routine_share_codes" ("created_at","updated_at","deleted_at","code","workout_routine_id","user_id","revoked_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, sqlmock.AnyArg(), wr.ID, u.ID, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			CreateRoutineShareCode struct {
+				Code             string
+				WorkoutRoutineID string
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation CreateRoutineShareCode {
+				createRoutineShareCode(workoutRoutineId: "%d") {
+					code
+					workoutRoutineId
+				}
+			}`,
+			wr.ID,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.NotEmpty(t, resp.CreateRoutineShareCode.Code)
+		require.Equal(t, utils.UIntToString(wr.ID), resp.CreateRoutineShareCode.WorkoutRoutineID)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Shared routine preview needs no account", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		expectSharedRoutine(mock, "sharecode")
+
+		var resp struct {
+			SharedRoutine struct {
+				Name             string
+				ExerciseRoutines []struct {
+					Name string
+					Sets int
+					Reps int
+				}
+			}
+		}
+		c.MustPost(`
+			query SharedRoutine {
+				sharedRoutine(code: "sharecode") {
+					name
+					exerciseRoutines {
+						name
+						sets
+						reps
+					}
+				}
+			}`, &resp)
+
+		require.Equal(t, wr.Name, resp.SharedRoutine.Name)
+		require.Len(t, resp.SharedRoutine.ExerciseRoutines, 2)
+		require.Equal(t, squat.Name, resp.SharedRoutine.ExerciseRoutines[0].Name)
+		require.Equal(t, legExtensions.Name, resp.SharedRoutine.ExerciseRoutines[1].Name)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Clone shared routine copies it into the caller's account", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		expectSharedRoutine(mock, "sharecode")
+
+		const clonedId = 90
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routines" ("created_at","updated_at","deleted_at","name","active","version","user_id") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, wr.Name, true, 1, u.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "active"}).AddRow(clonedId, true))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercise_routines" ("created_at","updated_at","deleted_at","name","sets","reps","active","position","workout_routine_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9),($10,$11,$12,$13,$14,$15,$16,$17,$18) ON CONFLICT ("id") DO UPDATE SET "workout_routine_id"="excluded"."workout_routine_id" RETURNING "id"`)).
+			WithArgs(
+				sqlmock.AnyArg(), sqlmock.AnyArg(), nil, squat.Name, squat.Sets, squat.Reps, true, 0, uint(clonedId),
+				sqlmock.AnyArg(), sqlmock.AnyArg(), nil, legExtensions.Name, legExtensions.Sets, legExtensions.Reps, true, 1, uint(clonedId),
+			).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(91).AddRow(92))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exercise_routines" WHERE workout_routine_id = $1`)).
+			WithArgs(utils.UIntToString(clonedId)).
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "name", "sets", "reps", "position", "workout_routine_id"}).
+				AddRow(91, squat.Name, squat.Sets, squat.Reps, 0, clonedId).
+				AddRow(92, legExtensions.Name, legExtensions.Sets, legExtensions.Reps, 1, clonedId))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routine_versions"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(clonedId), 1, wr.Name).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "exercise_routine_versions"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		mock.ExpectCommit()
+
+		var resp struct {
+			CloneSharedRoutine struct {
+				ID      string
+				Name    string
+				Version int
+			}
+		}
+		c.MustPost(`
+			mutation CloneSharedRoutine {
+				cloneSharedRoutine(code: "sharecode") {
+					id
+					name
+					version
+				}
+			}`, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Equal(t, utils.UIntToString(clonedId), resp.CloneSharedRoutine.ID)
+		require.Equal(t, wr.Name, resp.CloneSharedRoutine.Name)
+		require.Equal(t, 1, resp.CloneSharedRoutine.Version)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Revoked share codes can't be cloned", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		mock.ExpectQuery(regexp.QuoteMeta(sharedRoutineQuery)).
+			WithArgs("revoked").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		var resp struct{}
+		err := c.Post(`
+			mutation CloneSharedRoutine {
+				cloneSharedRoutine(code: "revoked") {
+					id
+				}
+			}`, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Error Cloning Routine: Share Code Invalid Or Revoked\",\"path\":[\"cloneSharedRoutine\"]}]")
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+}
@@ -0,0 +1,265 @@
+package test
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/joho/godotenv"
+	"github.com/neilZon/workout-logger-api/accesscontroller/accesscontrol"
+	"github.com/neilZon/workout-logger-api/config"
+	"github.com/neilZon/workout-logger-api/graph"
+	"github.com/neilZon/workout-logger-api/helpers"
+	"github.com/neilZon/workout-logger-api/ratelimit"
+	"github.com/neilZon/workout-logger-api/token"
+	"github.com/neilZon/workout-logger-api/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotpResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+	ACCESS_SECRET := []byte(os.Getenv(config.ACCESS_SECRET))
+	REFRESH_SECRET := []byte(os.Getenv(config.REFRESH_SECRET))
+
+	u := authUser()
+	// codes are worked out for a fixed time
+	now := time.Unix(1700000000, 0)
+	secret := "JBSWY3DPEHPK3PXP"
+	const challengeId = "challengeid"
+	const userQuery = `SELECT * FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`
+	const useChallengeQuery = `UPDATE "users" SET "totp_challenge_id"=$1,"updated_at"=$2 WHERE (id = $3 AND totp_challenge_id = $4) AND "users"."deleted_at" IS NULL`
+	verifyChallenge := func(challengeToken string, code string) string {
+		return fmt.Sprintf(`mutation VerifyTotpChallenge {
+			verifyTotpChallenge(challengeToken: "Bearer %s", code: "%s") {
+				accessToken
+			}
+		}`, challengeToken, code)
+	}
+
+	t.Run("Login with totp enabled returns a challenge", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+		keys, err := token.LoadKeys()
+		require.Nil(t, err)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified", "totp_secret", "totp_enabled"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true, secret, true)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)).
+			WithArgs(u.Email).
+			WillReturnRows(userRow)
+		mock.ExpectQuery(regexp.QuoteMeta(userQuery)).
+			WithArgs(fmt.Sprintf("%d", u.ID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "verified"}).AddRow(u.ID, true))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "password"=$1`)).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		var challengeIdArg challengeArg
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "totp_challenge_id"=$1,"updated_at"=$2 WHERE id = $3 AND "users"."deleted_at" IS NULL`)).
+			WithArgs(&challengeIdArg, sqlmock.AnyArg(), u.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			Login struct {
+				AccessToken    *string
+				ChallengeToken string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`mutation Login {
+			login(loginInput: { email: "%s", password: "password123" }) {
+				accessToken
+				challengeToken
+			}
+		}`, u.Email), &resp)
+		require.Nil(t, resp.Login.AccessToken)
+
+		claims, err := keys.Challenge.Decode("Bearer " + resp.Login.ChallengeToken)
+		require.Nil(t, err)
+		require.NotEmpty(t, claims.Id)
+		require.Equal(t, string(challengeIdArg), claims.Id)
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Verify totp challenge issues tokens", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		keys, err := token.LoadKeys()
+		require.Nil(t, err)
+
+		authenticator := totp.New(config.TOTP_ISSUER)
+		authenticator.Now = func() time.Time { return now }
+		c := client.New(helpers.NewGqlServer(&graph.Resolver{
+			DB:      gormDB,
+			Keys:    keys,
+			Limiter: ratelimit.NewLimiter(ratelimit.NewMemoryStore()),
+			TOTP:    authenticator,
+		}))
+
+		code, err := totp.Code(secret, now)
+		require.Nil(t, err)
+		challengeToken, err := keys.Challenge.Sign(&token.Credentials{ID: u.ID, Email: u.Email, Name: u.Name, TokenID: challengeId}, config.CHALLENGE_TTL)
+		require.Nil(t, err)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified", "totp_secret", "totp_enabled", "totp_last_step", "totp_challenge_id"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true, secret, true, 0, challengeId)
+		mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs(fmt.Sprintf("%d", u.ID)).WillReturnRows(userRow)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "totp_last_step"=$1,"updated_at"=$2 WHERE (id = $3 AND totp_last_step < $4) AND "users"."deleted_at" IS NULL`)).
+			WithArgs(totp.Step(now), sqlmock.AnyArg(), u.ID, totp.Step(now)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(useChallengeQuery)).
+			WithArgs(nil, sqlmock.AnyArg(), u.ID, challengeId).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "sessions"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "refresh_tokens"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			VerifyTotpChallenge struct {
+				AccessToken  string
+				RefreshToken string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`mutation VerifyTotpChallenge {
+			verifyTotpChallenge(challengeToken: "Bearer %s", code: "%s") {
+				accessToken
+				refreshToken
+			}
+		}`, challengeToken, code), &resp)
+		assert.True(t, token.Validate(resp.VerifyTotpChallenge.AccessToken, ACCESS_SECRET))
+		assert.True(t, token.Validate(resp.VerifyTotpChallenge.RefreshToken, REFRESH_SECRET))
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Verify totp challenge rejects access tokens", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		accessToken := token.Sign(&token.Credentials{ID: u.ID, Email: u.Email, Name: u.Name}, ACCESS_SECRET, config.ACCESS_TTL)
+
+		var resp struct{}
+		err := c.Post(fmt.Sprintf(`mutation VerifyTotpChallenge {
+			verifyTotpChallenge(challengeToken: "Bearer %s", code: "123456") {
+				accessToken
+			}
+		}`, accessToken), &resp)
+		require.EqualError(t, err, "[{\"message\":\"Challenge token invalid\",\"path\":[\"verifyTotpChallenge\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Verify totp challenge can't be used twice", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		keys, err := token.LoadKeys()
+		require.Nil(t, err)
+		c := client.New(helpers.NewGqlServer(&graph.Resolver{
+			DB:      gormDB,
+			Keys:    keys,
+			Limiter: ratelimit.NewLimiter(ratelimit.NewMemoryStore()),
+			TOTP:    totp.New(config.TOTP_ISSUER),
+		}))
+
+		challengeToken, err := keys.Challenge.Sign(&token.Credentials{ID: u.ID, Email: u.Email, Name: u.Name, TokenID: challengeId}, config.CHALLENGE_TTL)
+		require.Nil(t, err)
+
+		// the challenge was cleared when it was first used
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified", "totp_secret", "totp_enabled", "totp_challenge_id"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true, secret, true, nil)
+		mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs(fmt.Sprintf("%d", u.ID)).WillReturnRows(userRow)
+
+		var resp struct{}
+		err = c.Post(verifyChallenge(challengeToken, "123456"), &resp)
+		require.EqualError(t, err, "[{\"message\":\"Challenge token invalid\",\"path\":[\"verifyTotpChallenge\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Verify totp challenge rejects a code from a step already used", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		keys, err := token.LoadKeys()
+		require.Nil(t, err)
+		authenticator := totp.New(config.TOTP_ISSUER)
+		authenticator.Now = func() time.Time { return now }
+		c := client.New(helpers.NewGqlServer(&graph.Resolver{
+			DB:      gormDB,
+			Keys:    keys,
+			Limiter: ratelimit.NewLimiter(ratelimit.NewMemoryStore()),
+			TOTP:    authenticator,
+		}))
+
+		code, err := totp.Code(secret, now)
+		require.Nil(t, err)
+		challengeToken, err := keys.Challenge.Sign(&token.Credentials{ID: u.ID, Email: u.Email, Name: u.Name, TokenID: challengeId}, config.CHALLENGE_TTL)
+		require.Nil(t, err)
+
+		userRow := sqlmock.
+			NewRows([]string{"id", "name", "email", "password", "verified", "totp_secret", "totp_enabled", "totp_last_step", "totp_challenge_id"}).
+			AddRow(u.ID, u.Name, u.Email, u.Password, true, secret, true, totp.Step(now), challengeId)
+		mock.ExpectQuery(regexp.QuoteMeta(userQuery)).WithArgs(fmt.Sprintf("%d", u.ID)).WillReturnRows(userRow)
+
+		// the code no longer matches a totp step so it is tried as a recovery code
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "recovery_codes" SET "used_at"=$1,"updated_at"=$2 WHERE (user_id = $3 AND code_hash = $4 AND used_at IS NULL) AND "recovery_codes"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), u.ID, totp.HashRecoveryCode(code)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		var resp struct{}
+		err = c.Post(verifyChallenge(challengeToken, code), &resp)
+		require.EqualError(t, err, "[{\"message\":\"Invalid Code\",\"path\":[\"verifyTotpChallenge\"]}]")
+
+		err = mock.ExpectationsWereMet() // make sure all expectations were met
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+// challengeArg captures the challenge id login stores so it can be compared
+// with the one in the token
+type challengeArg string
+
+func (a *challengeArg) Match(v driver.Value) bool {
+	s, ok := v.(string)
+	*a = challengeArg(s)
+	return ok && s != ""
+}
@@ -87,7 +87,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 		mock.ExpectBegin()
 		const createWorkoutRoutineStmnt = `INSERT INTO "workout_routines" ("created_at","updated_at","deleted_at","name","active","user_id") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`
 		mock.ExpectQuery(regexp.QuoteMeta(createWorkoutRoutineStmnt)).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), wr.Name, wr.Active, wr.UserID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(wr.ID))
-		const createExerciseRoutineStmt = `INSERT INTO "exercise_routines" ("created_at","updated_at","deleted_at","name","sets","reps","active","workout_routine_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8),($9,$10,$11,$12,$13,$14,$15,$16) ON CONFLICT ("id") DO UPDATE SET "workout_routine_id"="excluded"."workout_routine_id" RETURNING "id"`
+		const createExerciseRoutineStmt = `INSERT INTO "exercise_routines" ("created_at","updated_at","deleted_at","name","sets","reps","active","position","workout_routine_id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9),($10,$11,$12,$13,$14,$15,$16,$17,$18) ON CONFLICT ("id") DO UPDATE SET "workout_routine_id"="excluded"."workout_routine_id" RETURNING "id"`
 		mock.ExpectQuery(regexp.QuoteMeta(createExerciseRoutineStmt)).WithArgs(
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
@@ -96,6 +96,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 			wr.ExerciseRoutines[0].Sets,
 			wr.ExerciseRoutines[0].Reps,
 			wr.ExerciseRoutines[0].Active,
+			0,
 			wr.ExerciseRoutines[0].WorkoutRoutineID,
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
@@ -104,6 +105,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 			wr.ExerciseRoutines[1].Sets,
 			wr.ExerciseRoutines[1].Reps,
 			wr.ExerciseRoutines[1].Active,
+			1,
 			wr.ExerciseRoutines[1].WorkoutRoutineID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(wr.ExerciseRoutines[0].ID).AddRow(wr.ExerciseRoutines[1].ID))
 		mock.ExpectCommit()
 
@@ -283,7 +285,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(utils.UIntToString(wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		workoutRoutineRow = sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
@@ -337,7 +339,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		mock.ExpectBegin()
 
@@ -358,7 +360,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 				wr.ExerciseRoutines[0].DeletedAt,
 				wr.ExerciseRoutines[0].UpdatedAt,
 			)
-		updateExerciseRoutineStmt := `INSERT INTO "exercise_routines" ("created_at","updated_at","deleted_at","name","sets","reps","active","workout_routine_id","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) ON CONFLICT ("id") DO UPDATE SET "reps"="excluded"."reps","sets"="excluded"."sets","name"="excluded"."name","active"="excluded"."active" RETURNING *`
+		updateExerciseRoutineStmt := `INSERT INTO "exercise_routines" ("created_at","updated_at","deleted_at","name","sets","reps","active","position","workout_routine_id","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) ON CONFLICT ("id") DO UPDATE SET "reps"="excluded"."reps","sets"="excluded"."sets","name"="excluded"."name","position"="excluded"."position" RETURNING *`
 		mock.ExpectQuery(regexp.QuoteMeta(updateExerciseRoutineStmt)).
 			WithArgs(
 				sqlmock.AnyArg(),
@@ -368,6 +370,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 				wr.ExerciseRoutines[0].Sets,
 				wr.ExerciseRoutines[0].Reps,
 				wr.Active,
+				0,
 				wr.ID,
 				wr.ExerciseRoutines[0].ID,
 			).WillReturnRows(exerciseRoutineRow)
@@ -473,7 +476,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, someRandomId, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		var resp UpdateWorkoutRoutine
 		mutation := fmt.Sprintf(`
@@ -525,7 +528,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		mock.ExpectBegin()
 
@@ -586,7 +589,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		mock.ExpectBegin()
 
@@ -670,7 +673,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, someRandomId, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		var resp DeleteWorkoutRoutineResp
 		gqlQuery := fmt.Sprintf(`
@@ -697,7 +700,7 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 		workoutRoutineRow := sqlmock.
 			NewRows([]string{"id", "name", "created_at", "deleted_at", "updated_at", "user_id", "active"}).
 			AddRow(wr.ID, wr.Name, wr.CreatedAt, wr.DeletedAt, wr.UpdatedAt, wr.UserID, wr.Active)
-		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(fmt.Sprintf("%d", wr.ID)).WillReturnRows(workoutRoutineRow)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
 
 		mock.ExpectBegin()
 
@@ -724,3 +727,460 @@ func TestWorkoutRoutineResolvers(t *testing.T) {
 		}
 	})
 }
+
+func TestUpdateWorkoutRoutineResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	wr := testdata.WorkoutRoutine
+	u := testdata.User
+
+	t.Run("Update Workout Routine Rejects Another Routine's Exercise Routines", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		// the caller's own exercise routine, but from one of their other routines
+		const otherExerciseRoutineId = 99
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+		exerciseRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(otherExerciseRoutineId, u.ID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.ExerciseRoutineAccessQuery)).WithArgs(otherExerciseRoutineId).WillReturnRows(exerciseRoutineRow)
+
+		helpers.ExpectWorkoutRoutineChangeStart(mock, wr.ID, wr.Name, 1)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "exercise_routines" WHERE (workout_routine_id = $1 AND id IN ($2)) AND "exercise_routines"."deleted_at" IS NULL`)).
+			WithArgs(wr.ID, otherExerciseRoutineId).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectRollback()
+
+		var resp UpdateWorkoutRoutine
+		mutation := fmt.Sprintf(`
+			mutation UpdateWorkoutRoutine {
+				updateWorkoutRoutine(
+					workoutRoutine: {
+						id: "%d"
+						name: "%s"
+						exerciseRoutines: [{ id: "%d", name: "Squat", sets: 5, reps: 5 }]
+					}
+				) {
+					id
+				}
+			}`,
+			wr.ID, wr.Name, otherExerciseRoutineId,
+		)
+		err := c.Post(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Error Updating Workout Routine: Exercise Routines Must Be The Workout Routine's\",\"path\":[\"updateWorkoutRoutine\"]}]")
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Update Workout Routine Without Exercise Routines Deletes Them All", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		helpers.ExpectWorkoutRoutineChangeStart(mock, wr.ID, wr.Name, 1)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "exercise_routines" SET "deleted_at"=$1 WHERE workout_routine_id = $2 AND "exercise_routines"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), wr.ID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "workout_routines" SET "name"=$1,"version"=$2,"updated_at"=$3 WHERE "workout_routines"."deleted_at" IS NULL AND "id" = $4`)).
+			WithArgs(wr.Name, 2, sqlmock.AnyArg(), wr.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exercise_routines" WHERE workout_routine_id = $1 AND "exercise_routines"."deleted_at" IS NULL ORDER BY position, id`)).
+			WithArgs(utils.UIntToString(wr.ID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workout_routine_versions"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, wr.ID, 2, wr.Name).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		var resp struct {
+			UpdateWorkoutRoutine struct {
+				ID      string
+				Version int
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation UpdateWorkoutRoutine {
+				updateWorkoutRoutine(workoutRoutine: { id: "%d", name: "%s", exerciseRoutines: [] }) {
+					id
+					version
+				}
+			}`,
+			wr.ID, wr.Name,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+		require.Equal(t, 2, resp.UpdateWorkoutRoutine.Version)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestWorkoutRoutineVersionResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	wr := testdata.WorkoutRoutine
+	u := testdata.User
+	const workoutRoutineVersionQuery = `SELECT * FROM "workout_routine_versions" WHERE (workout_routine_id = $1 AND version = $2) AND "workout_routine_versions"."deleted_at" IS NULL ORDER BY "workout_routine_versions"."id" LIMIT 1`
+	const exerciseRoutineVersionsQuery = `SELECT * FROM "exercise_routine_versions" WHERE "exercise_routine_versions"."workout_routine_version_id" = $1 AND "exercise_routine_versions"."deleted_at" IS NULL ORDER BY position, id`
+	squat, legExtensions := wr.ExerciseRoutines[0], wr.ExerciseRoutines[1]
+
+	t.Run("Rollback Workout Routine", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		// leg extensions were removed and the routine renamed after version 1
+		helpers.ExpectWorkoutRoutineChangeStart(mock, wr.ID, "Squat Day", 3)
+		mock.ExpectQuery(regexp.QuoteMeta(workoutRoutineVersionQuery)).
+			WithArgs(utils.UIntToString(wr.ID), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workout_routine_id", "version", "name"}).AddRow(20, wr.ID, 1, wr.Name))
+		mock.ExpectQuery(regexp.QuoteMeta(exerciseRoutineVersionsQuery)).
+			WithArgs(20).
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "workout_routine_version_id", "exercise_routine_id", "name", "sets", "reps", "position"}).
+				AddRow(21, 20, squat.ID, squat.Name, squat.Sets, squat.Reps, 0).
+				AddRow(22, 20, legExtensions.ID, legExtensions.Name, legExtensions.Sets, legExtensions.Reps, 1))
+		const restoreExerciseRoutineStmt = `UPDATE "exercise_routines" SET "deleted_at"=$1,"name"=$2,"position"=$3,"reps"=$4,"sets"=$5,"updated_at"=$6 WHERE id = $7 AND workout_routine_id = $8`
+		mock.ExpectExec(regexp.QuoteMeta(restoreExerciseRoutineStmt)).
+			WithArgs(nil, squat.Name, 0, squat.Reps, squat.Sets, sqlmock.AnyArg(), squat.ID, wr.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(restoreExerciseRoutineStmt)).
+			WithArgs(nil, legExtensions.Name, 1, legExtensions.Reps, legExtensions.Sets, sqlmock.AnyArg(), legExtensions.ID, wr.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "exercise_routines" SET "deleted_at"=$1 WHERE workout_routine_id = $2 AND id NOT IN ($3,$4) AND "exercise_routines"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), wr.ID, squat.ID, legExtensions.ID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		exerciseRoutineRows := sqlmock.
+			NewRows([]string{"id", "name", "sets", "reps", "position", "workout_routine_id"}).
+			AddRow(squat.ID, squat.Name, squat.Sets, squat.Reps, 0, wr.ID).
+			AddRow(legExtensions.ID, legExtensions.Name, legExtensions.Sets, legExtensions.Reps, 1, wr.ID)
+		helpers.ExpectWorkoutRoutineChangeSave(mock, wr.ID, wr.Name, 4, exerciseRoutineRows)
+
+		var resp struct {
+			RollbackWorkoutRoutine struct {
+				ID      string
+				Name    string
+				Version int
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation RollbackWorkoutRoutine {
+				rollbackWorkoutRoutine(workoutRoutineId: "%d", version: 1) {
+					id
+					name
+					version
+				}
+			}`,
+			wr.ID,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Equal(t, wr.Name, resp.RollbackWorkoutRoutine.Name)
+		require.Equal(t, 4, resp.RollbackWorkoutRoutine.Version)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Rollback Workout Routine Unknown Version", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		helpers.ExpectWorkoutRoutineChangeStart(mock, wr.ID, wr.Name, 3)
+		mock.ExpectQuery(regexp.QuoteMeta(workoutRoutineVersionQuery)).
+			WithArgs(utils.UIntToString(wr.ID), 9).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		var resp struct{}
+		mutation := fmt.Sprintf(`
+			mutation RollbackWorkoutRoutine {
+				rollbackWorkoutRoutine(workoutRoutineId: "%d", version: 9) {
+					id
+				}
+			}`,
+			wr.ID,
+		)
+		err := c.Post(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+		require.EqualError(t, err, "[{\"message\":\"Error Rolling Back Workout Routine: Version Not Found\",\"path\":[\"rollbackWorkoutRoutine\"]}]")
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Workout Routine Diff", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		exerciseRoutineVersionColumns := []string{"id", "workout_routine_version_id", "exercise_routine_id", "name", "sets", "reps", "position"}
+		mock.ExpectQuery(regexp.QuoteMeta(workoutRoutineVersionQuery)).
+			WithArgs(utils.UIntToString(wr.ID), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workout_routine_id", "version", "name"}).AddRow(20, wr.ID, 1, wr.Name))
+		mock.ExpectQuery(regexp.QuoteMeta(exerciseRoutineVersionsQuery)).
+			WithArgs(20).
+			WillReturnRows(sqlmock.NewRows(exerciseRoutineVersionColumns).
+				AddRow(21, 20, squat.ID, squat.Name, 5, 5, 0).
+				AddRow(22, 20, legExtensions.ID, legExtensions.Name, 3, 10, 1))
+		// squat went to 5x3, leg extensions were swapped for lunges
+		mock.ExpectQuery(regexp.QuoteMeta(workoutRoutineVersionQuery)).
+			WithArgs(utils.UIntToString(wr.ID), 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workout_routine_id", "version", "name"}).AddRow(30, wr.ID, 2, wr.Name))
+		mock.ExpectQuery(regexp.QuoteMeta(exerciseRoutineVersionsQuery)).
+			WithArgs(30).
+			WillReturnRows(sqlmock.NewRows(exerciseRoutineVersionColumns).
+				AddRow(31, 30, squat.ID, squat.Name, 5, 3, 0).
+				AddRow(32, 30, 5, "lunges", 3, 12, 1))
+
+		var resp struct {
+			WorkoutRoutineDiff struct {
+				From    int
+				To      int
+				Changes []struct {
+					ExerciseRoutineID string
+					Type              string
+					Before            *struct{ Reps int }
+					After             *struct{ Reps int }
+				}
+			}
+		}
+		query := fmt.Sprintf(`
+			query WorkoutRoutineDiff {
+				workoutRoutineDiff(workoutRoutineId: "%d", from: 1, to: 2) {
+					from
+					to
+					changes {
+						exerciseRoutineId
+						type
+						before { reps }
+						after { reps }
+					}
+				}
+			}`,
+			wr.ID,
+		)
+		c.MustPost(query, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		diff := resp.WorkoutRoutineDiff
+		require.Equal(t, 1, diff.From)
+		require.Equal(t, 2, diff.To)
+		require.Len(t, diff.Changes, 3)
+		require.Equal(t, utils.UIntToString(legExtensions.ID), diff.Changes[0].ExerciseRoutineID)
+		require.Equal(t, "REMOVED", diff.Changes[0].Type)
+		require.Nil(t, diff.Changes[0].After)
+		require.Equal(t, utils.UIntToString(squat.ID), diff.Changes[1].ExerciseRoutineID)
+		require.Equal(t, "CHANGED", diff.Changes[1].Type)
+		require.Equal(t, 5, diff.Changes[1].Before.Reps)
+		require.Equal(t, 3, diff.Changes[1].After.Reps)
+		require.Equal(t, "5", diff.Changes[2].ExerciseRoutineID)
+		require.Equal(t, "ADDED", diff.Changes[2].Type)
+		require.Nil(t, diff.Changes[2].Before)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Workout Routine Diff Of A Reorder", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		exerciseRoutineVersionColumns := []string{"id", "workout_routine_version_id", "exercise_routine_id", "name", "sets", "reps", "position"}
+		mock.ExpectQuery(regexp.QuoteMeta(workoutRoutineVersionQuery)).
+			WithArgs(utils.UIntToString(wr.ID), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workout_routine_id", "version", "name"}).AddRow(20, wr.ID, 1, wr.Name))
+		mock.ExpectQuery(regexp.QuoteMeta(exerciseRoutineVersionsQuery)).
+			WithArgs(20).
+			WillReturnRows(sqlmock.NewRows(exerciseRoutineVersionColumns).
+				AddRow(21, 20, squat.ID, squat.Name, 5, 5, 0).
+				AddRow(22, 20, legExtensions.ID, legExtensions.Name, 3, 10, 1))
+		// leg extensions were moved in front of squat and nothing else changed
+		mock.ExpectQuery(regexp.QuoteMeta(workoutRoutineVersionQuery)).
+			WithArgs(utils.UIntToString(wr.ID), 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workout_routine_id", "version", "name"}).AddRow(30, wr.ID, 2, wr.Name))
+		mock.ExpectQuery(regexp.QuoteMeta(exerciseRoutineVersionsQuery)).
+			WithArgs(30).
+			WillReturnRows(sqlmock.NewRows(exerciseRoutineVersionColumns).
+				AddRow(31, 30, legExtensions.ID, legExtensions.Name, 3, 10, 0).
+				AddRow(32, 30, squat.ID, squat.Name, 5, 5, 1))
+
+		var resp struct {
+			WorkoutRoutineDiff struct {
+				Changes []struct {
+					ExerciseRoutineID string
+					Type              string
+					Before            *struct{ Position int }
+					After             *struct{ Position int }
+				}
+			}
+		}
+		query := fmt.Sprintf(`
+			query WorkoutRoutineDiff {
+				workoutRoutineDiff(workoutRoutineId: "%d", from: 1, to: 2) {
+					changes {
+						exerciseRoutineId
+						type
+						before { position }
+						after { position }
+					}
+				}
+			}`,
+			wr.ID,
+		)
+		c.MustPost(query, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		changes := resp.WorkoutRoutineDiff.Changes
+		require.Len(t, changes, 2)
+		require.Equal(t, utils.UIntToString(legExtensions.ID), changes[0].ExerciseRoutineID)
+		require.Equal(t, "MOVED", changes[0].Type)
+		require.Equal(t, 1, changes[0].Before.Position)
+		require.Equal(t, 0, changes[0].After.Position)
+		require.Equal(t, utils.UIntToString(squat.ID), changes[1].ExerciseRoutineID)
+		require.Equal(t, "MOVED", changes[1].Type)
+		require.Equal(t, 0, changes[1].Before.Position)
+		require.Equal(t, 1, changes[1].After.Position)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestArchiveWorkoutRoutineResolvers(t *testing.T) {
+	t.Parallel()
+
+	err := godotenv.Load("../.env")
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
+	wr := testdata.WorkoutRoutine
+	u := testdata.User
+
+	t.Run("Archive Workout Routine", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		workoutRoutineRow := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(wr.ID, wr.UserID)
+		mock.ExpectQuery(regexp.QuoteMeta(helpers.WorkoutRoutineAccessQuery)).WithArgs(wr.ID).WillReturnRows(workoutRoutineRow)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "workout_routines" SET "active"=$1,"updated_at"=$2 WHERE id = $3 AND "workout_routines"."deleted_at" IS NULL RETURNING *`)).
+			WithArgs(false, sqlmock.AnyArg(), utils.UIntToString(wr.ID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "active", "version"}).AddRow(wr.ID, wr.Name, false, 2))
+		mock.ExpectCommit()
+
+		var resp struct {
+			ArchiveWorkoutRoutine struct {
+				ID     string
+				Active bool
+			}
+		}
+		mutation := fmt.Sprintf(`
+			mutation ArchiveWorkoutRoutine {
+				archiveWorkoutRoutine(workoutRoutineId: "%d") {
+					id
+					active
+				}
+			}`,
+			wr.ID,
+		)
+		c.MustPost(mutation, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Equal(t, utils.UIntToString(wr.ID), resp.ArchiveWorkoutRoutine.ID)
+		require.False(t, resp.ArchiveWorkoutRoutine.Active)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	t.Run("Get Workout Routines Filtered By Active", func(t *testing.T) {
+		mock, gormDB := helpers.SetupMockDB()
+		acs := accesscontrol.NewAccessControllerService(gormDB)
+		c := helpers.NewGqlClient(gormDB, acs)
+
+		helpers.ExpectVerified(mock, u)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "workout_routines" WHERE user_id = $1 AND active = $2 AND "workout_routines"."deleted_at" IS NULL ORDER BY id LIMIT 10`)).
+			WithArgs(utils.UIntToString(u.ID), false).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "active", "version"}).AddRow(wr.ID, wr.Name, false, 2))
+
+		var resp struct {
+			WorkoutRoutines struct {
+				Edges []struct {
+					Node struct {
+						ID     string
+						Active bool
+					}
+				}
+			}
+		}
+		c.MustPost(`
+			query WorkoutRoutines {
+				workoutRoutines(limit: 10, active: false) {
+					edges {
+						node {
+							id
+							active
+						}
+					}
+				}
+			}`, &resp, helpers.AddContext(u, helpers.NewLoaders(gormDB)))
+
+		require.Len(t, resp.WorkoutRoutines.Edges, 1)
+		require.False(t, resp.WorkoutRoutines.Edges[0].Node.Active)
+
+		err = mock.ExpectationsWereMet()
+		if err != nil {
+			panic(err)
+		}
+	})
+}
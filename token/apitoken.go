@@ -0,0 +1,75 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// ApiTokenPrefix marks a bearer token as a personal access token rather than a jwt
+const ApiTokenPrefix = "uf_pat_"
+
+// length of the token shown back to the user to tell tokens apart
+const apiTokenDisplayLength = 12
+
+// scopes an api token can be granted, a token is only allowed to do what its scopes cover
+const (
+	UserRead      = "user:read"
+	RoutinesRead  = "routines:read"
+	RoutinesWrite = "routines:write"
+	SessionsRead  = "sessions:read"
+	SessionsWrite = "sessions:write"
+)
+
+var Scopes = []string{UserRead, RoutinesRead, RoutinesWrite, SessionsRead, SessionsWrite}
+
+func ValidScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateApiToken returns a new api token, its hash to store and the prefix to display
+func GenerateApiToken() (plaintext string, hash string, prefix string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	plaintext = ApiTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return plaintext, HashApiToken(plaintext), plaintext[:apiTokenDisplayLength], nil
+}
+
+// HashApiToken hashes an api token for storage and lookup. The token has
+// enough entropy that a fast hash is fine.
+func HashApiToken(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsApiToken reports whether a bearer token string holds an api token
+func IsApiToken(tokenString string) (string, bool) {
+	f := strings.Fields(tokenString)
+	if len(f) != 2 || f[0] != "Bearer" || !strings.HasPrefix(f[1], ApiTokenPrefix) {
+		return "", false
+	}
+	return f[1], true
+}
+
+// HasScope reports whether the claims allow scope. Claims from a login
+// aren't limited by scopes, only ones from api tokens are.
+func (c *Claims) HasScope(scope string) bool {
+	if c.ApiTokenID == 0 {
+		return true
+	}
+	for _, s := range strings.Fields(c.Scope) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
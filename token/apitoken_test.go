@@ -0,0 +1,48 @@
+package token
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Generates distinct prefixed tokens", func(t *testing.T) {
+		plaintext, hash, prefix, err := GenerateApiToken()
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(plaintext, ApiTokenPrefix))
+		assert.True(t, strings.HasPrefix(plaintext, prefix))
+		assert.Equal(t, HashApiToken(plaintext), hash)
+
+		other, _, _, err := GenerateApiToken()
+		assert.Nil(t, err)
+		assert.NotEqual(t, plaintext, other)
+	})
+
+	t.Run("Recognizes api tokens in the authorization header", func(t *testing.T) {
+		plaintext, _, _, _ := GenerateApiToken()
+
+		tkn, ok := IsApiToken("Bearer " + plaintext)
+		assert.True(t, ok)
+		assert.Equal(t, plaintext, tkn)
+
+		_, ok = IsApiToken("Bearer eyJhbGciOiJIUzI1NiJ9.e30.sig")
+		assert.False(t, ok)
+		_, ok = IsApiToken(plaintext)
+		assert.False(t, ok)
+	})
+
+	t.Run("Only api token claims are limited by scope", func(t *testing.T) {
+		login := &Claims{ID: 1}
+		assert.True(t, login.HasScope(SessionsWrite))
+
+		api := &Claims{ID: 1, ApiTokenID: 3, Scope: SessionsRead + " " + RoutinesRead}
+		assert.True(t, api.HasScope(SessionsRead))
+		assert.True(t, api.HasScope(RoutinesRead))
+		assert.False(t, api.HasScope(SessionsWrite))
+		assert.False(t, api.HasScope("sessions"))
+	})
+}
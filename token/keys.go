@@ -0,0 +1,346 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/neilZon/workout-logger-api/config"
+)
+
+// what a token is allowed to be used for, carried in the Use claim
+const (
+	AccessUse    = "access"
+	RefreshUse   = "refresh"
+	ChallengeUse = "challenge"
+)
+
+const (
+	privateKeySuffix = ".pem"
+	publicKeySuffix  = ".pub.pem"
+)
+
+// Key is a single signing or verification key identified by its kid
+type Key struct {
+	ID     string
+	Method jwt.SigningMethod
+	// nil for keys that can only verify
+	signingKey interface{}
+	verifyKey  interface{}
+}
+
+func (k *Key) symmetric() bool {
+	_, ok := k.Method.(*jwt.SigningMethodHMAC)
+	return ok
+}
+
+func NewHMACKey(kid string, secret []byte) *Key {
+	return &Key{
+		ID:         kid,
+		Method:     jwt.SigningMethodHS256,
+		signingKey: secret,
+		verifyKey:  secret,
+	}
+}
+
+// ParsePrivateKey reads a PEM encoded RSA (RS256) or Ed25519 (EdDSA) private key
+func ParsePrivateKey(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("key %s is not PEM encoded", kid)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse private key %s: %w", kid, err)
+		}
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, signingKey: k, verifyKey: &k.PublicKey}, nil
+	case ed25519.PrivateKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, signingKey: k, verifyKey: k.Public()}, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T for key %s", parsed, kid)
+	}
+}
+
+// ParsePublicKey reads a PEM encoded RSA or Ed25519 public key that is only used for verifying
+func ParsePublicKey(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("key %s is not PEM encoded", kid)
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse public key %s: %w", kid, err)
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PublicKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, verifyKey: k}, nil
+	case ed25519.PublicKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, verifyKey: k}, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T for key %s", parsed, kid)
+	}
+}
+
+// KeySet signs tokens for one use with its active key and verifies them
+// with any key it knows about, so keys can be rotated without
+// invalidating tokens that are still in flight
+type KeySet struct {
+	use     string
+	signing *Key
+	keys    map[string]*Key
+	// accept symmetric tokens issued before the use claim existed
+	legacy bool
+}
+
+func NewKeySet(use string, signing *Key, verification ...*Key) (*KeySet, error) {
+	if signing == nil || signing.signingKey == nil {
+		return nil, errors.New("key set needs a private key to sign with")
+	}
+
+	ks := &KeySet{
+		use:     use,
+		signing: signing,
+		keys:    map[string]*Key{signing.ID: signing},
+	}
+	for _, k := range verification {
+		ks.keys[k.ID] = k
+	}
+	return ks, nil
+}
+
+// Sign signs a token for the key set's use with the active key that is valid for ttl
+func (ks *KeySet) Sign(c *Credentials, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		Name:      c.Name,
+		ID:        c.ID,
+		SessionID: c.SessionID,
+		Use:       ks.use,
+		Role:      c.Role,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: now.Add(ttl).Unix(),
+			IssuedAt:  now.Unix(),
+			NotBefore: now.Unix(),
+			Issuer:    "neil:)",
+			Subject:   c.Email,
+			Id:        c.TokenID,
+		},
+	}
+
+	t := jwt.NewWithClaims(ks.signing.Method, claims)
+	if ks.signing.ID != "" {
+		t.Header["kid"] = ks.signing.ID
+	}
+	return t.SignedString(ks.signing.signingKey)
+}
+
+// Decode verifies a "Bearer" token string against the key named in its kid header
+func (ks *KeySet) Decode(tokenString string) (*Claims, error) {
+	f := strings.Fields(tokenString)
+	if len(f) != 2 || f[0] != "Bearer" {
+		return nil, errors.New("Missing type \"Bearer\" in token string")
+	}
+
+	var key *Key
+	t, err := jwt.ParseWithClaims(f[1], &Claims{}, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		k, ok := ks.keys[kid]
+		if !ok {
+			// tokens signed before key ids were added only ever used the secret
+			k, ok = ks.keys[""]
+		}
+		if !ok {
+			return nil, fmt.Errorf("Unknown signing key %q", kid)
+		}
+		if t.Method.Alg() != k.Method.Alg() {
+			return nil, fmt.Errorf("Unexpected signing method: %v", t.Header["alg"])
+		}
+		key = k
+		return k.verifyKey, nil
+	})
+	if err != nil {
+		return &Claims{}, err
+	}
+
+	claims, ok := t.Claims.(*Claims)
+	if !ok || !t.Valid {
+		return &Claims{}, errors.New("invalid token")
+	}
+
+	// symmetric tokens issued before the use claim existed are kept apart by
+	// their secrets, asymmetric ones share keys so the claim must match
+	if claims.Use != ks.use && !(ks.legacy && claims.Use == "" && key.symmetric()) {
+		return &Claims{}, fmt.Errorf("token cannot be used as an %s token", ks.use)
+	}
+
+	return claims, nil
+}
+
+// Keys holds the key sets for each kind of token the api issues
+type Keys struct {
+	Access  *KeySet
+	Refresh *KeySet
+	// short lived tokens handed out between the password and second factor steps of a login
+	Challenge *KeySet
+}
+
+// LoadKeys reads the signing keys from the directory in JWT_KEYS_DIR. Files
+// named <kid>.pem hold private keys, <kid>.pub.pem hold retired public keys
+// that are still accepted. JWT_SIGNING_KEY_ID picks the active key.
+// Without a key directory tokens are signed with the ACCESS_SECRET and
+// REFRESH_SECRET hmac secrets.
+func LoadKeys() (*Keys, error) {
+	dir := os.Getenv(config.JWT_KEYS_DIR)
+	if dir == "" {
+		// no kid so tokens signed before keys were named still verify
+		access, err := NewKeySet(AccessUse, NewHMACKey("", []byte(os.Getenv(config.ACCESS_SECRET))))
+		if err != nil {
+			return nil, err
+		}
+		refresh, err := NewKeySet(RefreshUse, NewHMACKey("", []byte(os.Getenv(config.REFRESH_SECRET))))
+		if err != nil {
+			return nil, err
+		}
+		access.legacy = true
+		refresh.legacy = true
+
+		// challenges always carry their use so they can share the access secret
+		challenge, err := NewKeySet(ChallengeUse, NewHMACKey("", []byte(os.Getenv(config.ACCESS_SECRET))))
+		if err != nil {
+			return nil, err
+		}
+		return &Keys{Access: access, Refresh: refresh, Challenge: challenge}, nil
+	}
+
+	keys, err := readKeyDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	signingKeyId := os.Getenv(config.JWT_SIGNING_KEY_ID)
+	signing, ok := keys[signingKeyId]
+	if !ok {
+		return nil, fmt.Errorf("signing key %q not found in %s", signingKeyId, dir)
+	}
+
+	var verification []*Key
+	for _, k := range keys {
+		verification = append(verification, k)
+	}
+
+	access, err := NewKeySet(AccessUse, signing, verification...)
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := NewKeySet(RefreshUse, signing, verification...)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := NewKeySet(ChallengeUse, signing, verification...)
+	if err != nil {
+		return nil, err
+	}
+	return &Keys{Access: access, Refresh: refresh, Challenge: challenge}, nil
+}
+
+func readKeyDir(dir string) (map[string]*Key, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]*Key{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, privateKeySuffix) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		var k *Key
+		if strings.HasSuffix(name, publicKeySuffix) {
+			k, err = ParsePublicKey(strings.TrimSuffix(name, publicKeySuffix), data)
+		} else {
+			k, err = ParsePrivateKey(strings.TrimSuffix(name, privateKeySuffix), data)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// a private key wins over a public key with the same kid
+		if existing, ok := keys[k.ID]; ok && existing.signingKey != nil {
+			continue
+		}
+		keys[k.ID] = k
+	}
+	return keys, nil
+}
+
+// JWK is the public half of a key in JSON Web Key format
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS lists the public keys of the set. Symmetric keys are never published.
+func (ks *KeySet) JWKS() *JWKS {
+	jwks := &JWKS{Keys: []JWK{}}
+	for _, k := range ks.keys {
+		switch pub := k.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "RSA",
+				Kid: k.ID,
+				Use: "sig",
+				Alg: k.Method.Alg(),
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "OKP",
+				Kid: k.ID,
+				Use: "sig",
+				Alg: k.Method.Alg(),
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+	sort.Slice(jwks.Keys, func(i, j int) bool { return jwks.Keys[i].Kid < jwks.Keys[j].Kid })
+	return jwks
+}
@@ -0,0 +1,164 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/neilZon/workout-logger-api/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePrivateKey(t *testing.T, dir string, kid string, key interface{}) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.Nil(t, err)
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	require.Nil(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0600))
+}
+
+func writePublicKey(t *testing.T, dir string, kid string, key interface{}) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.Nil(t, err)
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	require.Nil(t, os.WriteFile(filepath.Join(dir, kid+".pub.pem"), data, 0600))
+}
+
+func TestKeys(t *testing.T) {
+	c := Credentials{
+		ID:    12,
+		Email: "test@test.com",
+		Name:  "testname",
+	}
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	t.Run("Sign and decode with an rsa key", func(t *testing.T) {
+		dir := t.TempDir()
+		writePrivateKey(t, dir, "rsa-1", rsaKey)
+		t.Setenv(config.JWT_KEYS_DIR, dir)
+		t.Setenv(config.JWT_SIGNING_KEY_ID, "rsa-1")
+
+		keys, err := LoadKeys()
+		require.Nil(t, err)
+
+		tkn, err := keys.Access.Sign(&c, time.Hour)
+		require.Nil(t, err)
+
+		claims, err := keys.Access.Decode("Bearer " + tkn)
+		require.Nil(t, err)
+		assert.Equal(t, "test@test.com", claims.Subject)
+		assert.Equal(t, AccessUse, claims.Use)
+	})
+
+	t.Run("Retired keys still verify after rotation", func(t *testing.T) {
+		dir := t.TempDir()
+		writePrivateKey(t, dir, "rsa-1", rsaKey)
+		t.Setenv(config.JWT_KEYS_DIR, dir)
+		t.Setenv(config.JWT_SIGNING_KEY_ID, "rsa-1")
+
+		oldKeys, err := LoadKeys()
+		require.Nil(t, err)
+		oldToken, err := oldKeys.Access.Sign(&c, time.Hour)
+		require.Nil(t, err)
+
+		// rotate to the ed25519 key and keep only the public half of the old key
+		require.Nil(t, os.Remove(filepath.Join(dir, "rsa-1.pem")))
+		writePublicKey(t, dir, "rsa-1", &rsaKey.PublicKey)
+		writePrivateKey(t, dir, "ed-1", edPrivate)
+		t.Setenv(config.JWT_SIGNING_KEY_ID, "ed-1")
+
+		keys, err := LoadKeys()
+		require.Nil(t, err)
+
+		_, err = keys.Access.Decode("Bearer " + oldToken)
+		assert.Nil(t, err, "token signed with the retired key should verify")
+
+		newToken, err := keys.Access.Sign(&c, time.Hour)
+		require.Nil(t, err)
+		_, err = keys.Access.Decode("Bearer " + newToken)
+		assert.Nil(t, err, "token signed with the new key should verify")
+	})
+
+	t.Run("Refresh tokens are not accepted as access tokens", func(t *testing.T) {
+		dir := t.TempDir()
+		writePrivateKey(t, dir, "ed-1", edPrivate)
+		t.Setenv(config.JWT_KEYS_DIR, dir)
+		t.Setenv(config.JWT_SIGNING_KEY_ID, "ed-1")
+
+		keys, err := LoadKeys()
+		require.Nil(t, err)
+
+		refreshToken, err := keys.Refresh.Sign(&c, time.Hour)
+		require.Nil(t, err)
+
+		_, err = keys.Access.Decode("Bearer " + refreshToken)
+		assert.NotNil(t, err, "refresh token should not decode as an access token")
+	})
+
+	t.Run("Unknown key ids are rejected", func(t *testing.T) {
+		other, err := NewKeySet(AccessUse, &Key{ID: "other", Method: jwt.SigningMethodRS256, signingKey: rsaKey, verifyKey: &rsaKey.PublicKey})
+		require.Nil(t, err)
+		tkn, err := other.Sign(&c, time.Hour)
+		require.Nil(t, err)
+
+		ks, err := NewKeySet(AccessUse, &Key{ID: "ed-1", Method: jwt.SigningMethodEdDSA, signingKey: edPrivate, verifyKey: edPublic})
+		require.Nil(t, err)
+		_, err = ks.Decode("Bearer " + tkn)
+		assert.NotNil(t, err, "token signed with an unknown key should not decode")
+	})
+
+	t.Run("Falls back to hmac secrets without a key directory", func(t *testing.T) {
+		t.Setenv(config.JWT_KEYS_DIR, "")
+		t.Setenv(config.ACCESS_SECRET, "accesssecret")
+
+		keys, err := LoadKeys()
+		require.Nil(t, err)
+
+		tkn, err := keys.Access.Sign(&c, time.Hour)
+		require.Nil(t, err)
+		assert.True(t, Validate(tkn, []byte("accesssecret")))
+
+		// tokens signed before key sets existed still decode
+		legacy := Sign(&c, []byte("accesssecret"), 1)
+		_, err = keys.Access.Decode("Bearer " + legacy)
+		assert.Nil(t, err)
+		assert.Empty(t, keys.Access.JWKS().Keys, "secrets should never be published")
+
+		// but not as challenges even though they share the secret
+		_, err = keys.Challenge.Decode("Bearer " + legacy)
+		assert.NotNil(t, err, "legacy token should not decode as a challenge")
+		_, err = keys.Challenge.Decode("Bearer " + tkn)
+		assert.NotNil(t, err, "access token should not decode as a challenge")
+	})
+
+	t.Run("JWKS publishes public keys", func(t *testing.T) {
+		dir := t.TempDir()
+		writePrivateKey(t, dir, "rsa-1", rsaKey)
+		writePrivateKey(t, dir, "ed-1", edPrivate)
+		t.Setenv(config.JWT_KEYS_DIR, dir)
+		t.Setenv(config.JWT_SIGNING_KEY_ID, "ed-1")
+
+		keys, err := LoadKeys()
+		require.Nil(t, err)
+
+		jwks := keys.Access.JWKS()
+		require.Len(t, jwks.Keys, 2)
+		assert.Equal(t, "ed-1", jwks.Keys[0].Kid)
+		assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+		assert.Equal(t, "EdDSA", jwks.Keys[0].Alg)
+		assert.Equal(t, "rsa-1", jwks.Keys[1].Kid)
+		assert.Equal(t, "RSA", jwks.Keys[1].Kty)
+		assert.Equal(t, "AQAB", jwks.Keys[1].E)
+	})
+}
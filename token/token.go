@@ -13,11 +13,22 @@ type Credentials struct {
 	ID    uint
 	Name  string
 	Email string
+	// optional unique id (jti) for tokens that are tracked server side
+	TokenID string
+	// session (device login) the token was issued to
+	SessionID uint
+	Role      string
 }
 
 type Claims struct {
-	Name string
-	ID   uint
+	Name      string
+	ID        uint
+	SessionID uint   `json:",omitempty"`
+	Use       string `json:",omitempty"`
+	Role      string `json:",omitempty"`
+	// set when the request was authenticated with an api token rather than a login
+	ApiTokenID uint   `json:",omitempty"`
+	Scope      string `json:",omitempty"`
 	jwt.StandardClaims
 }
 
@@ -26,12 +37,18 @@ func Sign(c *Credentials, secret []byte, ttl time.Duration) string {
 	claims := Claims{
 		c.Name,
 		c.ID,
+		c.SessionID,
+		"",
+		c.Role,
+		0,
+		"",
 		jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(ttl * time.Hour).Unix(),
 			IssuedAt:  time.Now().Unix(),
 			NotBefore: time.Now().Unix(),
 			Issuer:    "neil:)",
 			Subject:   c.Email,
+			Id:        c.TokenID,
 		},
 	}
 
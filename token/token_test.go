@@ -43,4 +43,17 @@ func TestToken(t *testing.T) {
 
 		assert.NotNil(t, err, "Should be an error decoding a token")
 	})
+
+	t.Run("Token and session ids are carried in the claims", func(t *testing.T) {
+		withId := c
+		withId.TokenID = "sometokenid"
+		withId.SessionID = 9
+		tkn := Sign(&withId, []byte(secret), ttl)
+
+		claims, err := Decode("Bearer "+tkn, []byte(secret))
+
+		assert.Nil(t, err, "Error decoding token")
+		assert.Equal(t, claims.Id, "sometokenid")
+		assert.Equal(t, claims.SessionID, uint(9))
+	})
 }
@@ -0,0 +1,41 @@
+package totp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	RecoveryCodeCount = 10
+	// 16 base32 characters is 80 bits, enough that a plain sha256 is a safe hash
+	recoveryCodeLength = 16
+)
+
+// GenerateRecoveryCodes returns one-time codes formatted as xxxx-xxxx-xxxx-xxxx
+func GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	for i := 0; i < RecoveryCodeCount; i++ {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		raw := strings.ToLower(encoding.EncodeToString(b))[:recoveryCodeLength]
+
+		var parts []string
+		for j := 0; j < len(raw); j += 4 {
+			parts = append(parts, raw[j:j+4])
+		}
+		codes = append(codes, strings.Join(parts, "-"))
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode normalizes a recovery code the way a user might type it
+// and hashes it for storage
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
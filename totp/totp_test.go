@@ -0,0 +1,72 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotp(t *testing.T) {
+	// secret from the RFC 6238 test vectors
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	t.Run("Matches the RFC 6238 test vectors", func(t *testing.T) {
+		vectors := map[int64]string{
+			59:          "287082",
+			1111111109:  "081804",
+			1111111111:  "050471",
+			1234567890:  "005924",
+			2000000000:  "279037",
+			20000000000: "353130",
+		}
+		for unix, expected := range vectors {
+			code, err := Code(secret, time.Unix(unix, 0))
+			require.Nil(t, err)
+			assert.Equal(t, expected, code, "code at %d", unix)
+		}
+	})
+
+	t.Run("Accepts codes within the skew and rejects replays", func(t *testing.T) {
+		now := time.Unix(1111111111, 0)
+		a := New("Til Failure")
+		a.Now = func() time.Time { return now }
+
+		previous, err := Code(secret, now.Add(-Period))
+		require.Nil(t, err)
+
+		step, ok := a.Validate(secret, previous, 0)
+		require.True(t, ok)
+		assert.Equal(t, Step(now)-1, step)
+
+		_, ok = a.Validate(secret, previous, step)
+		assert.False(t, ok, "code should not be accepted twice")
+
+		old, err := Code(secret, now.Add(-3*Period))
+		require.Nil(t, err)
+		_, ok = a.Validate(secret, old, 0)
+		assert.False(t, ok, "code outside the skew should be rejected")
+	})
+
+	t.Run("Builds an otpauth uri", func(t *testing.T) {
+		a := New("Til Failure")
+		uri := a.URI("JBSWY3DPEHPK3PXP", "test@test.com")
+		assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Til%20Failure:test@test.com?"))
+		assert.Contains(t, uri, "secret=JBSWY3DPEHPK3PXP")
+		assert.Contains(t, uri, "issuer=Til+Failure")
+	})
+
+	t.Run("Recovery codes hash the same however they are typed", func(t *testing.T) {
+		codes, err := GenerateRecoveryCodes()
+		require.Nil(t, err)
+		require.Len(t, codes, RecoveryCodeCount)
+
+		code := codes[0]
+		assert.Len(t, code, 19)
+		assert.Equal(t, HashRecoveryCode(code), HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", " "))))
+		assert.NotEqual(t, HashRecoveryCode(codes[0]), HashRecoveryCode(codes[1]))
+	})
+}
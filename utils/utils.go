@@ -1,11 +1,11 @@
 package utils
 
 import (
+	"crypto/rand"
 	"encoding/base64"
 	"fmt"
-	"math/rand"
+	"math/big"
 	"strconv"
-	"time"
 )
 
 func StringToUInt(s string) uint {
@@ -22,8 +22,6 @@ func UIntToString(num uint) string {
 
 // generate URL safe code
 func GenerateVerificationCode(length int) (string, error) {
-	rand.Seed(time.Now().UnixNano())
-
 	// Generate a random byte slice of the specified length
 	randomBytes := make([]byte, length)
 	_, err := rand.Read(randomBytes)
@@ -34,3 +32,13 @@ func GenerateVerificationCode(length int) (string, error) {
 	// Encode the random byte slice using base64.URLEncoding, which produces a URL-safe string
 	return base64.URLEncoding.EncodeToString(randomBytes), nil
 }
+
+// generate a code of digits that is easy to type, e.g. "042917"
+func GenerateNumericCode(digits int) (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", digits, n), nil
+}
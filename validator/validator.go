@@ -4,11 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"regexp"
 
 	"github.com/neilZon/workout-logger-api/graph/model"
+	"github.com/neilZon/workout-logger-api/password"
 )
 
-func SignupInputIsValid(s *model.SignupInput) error {
+func SignupInputIsValid(s *model.SignupInput, policy *password.Policy) error {
 	if _, err := mail.ParseAddress(s.Email); err != nil {
 		return errors.New("not a valid email")
 	}
@@ -17,14 +19,20 @@ func SignupInputIsValid(s *model.SignupInput) error {
 		return errors.New("name needs to be between 2 and 50 characters")
 	}
 
-	if !passwordLongEnough(s.Password) || !hasNumber(s.Password) {
-		return errors.New("password needs at least 1 number and 8 - 32 characters")
+	if err := policy.Validate(s.Password, s.Email, s.Name); err != nil {
+		return err
 	}
 
 	if s.Password != s.ConfirmPassword {
 		return errors.New("passwords don't match")
 	}
 
+	if s.Locale != nil {
+		if err := ValidateLocale(*s.Locale); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -35,6 +43,16 @@ func ValidateEmail(email string) error {
 	return nil
 }
 
+// language tags such as "en", "es-MX" or "zh_Hant"
+var localePattern = regexp.MustCompile(`^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*$`)
+
+func ValidateLocale(locale string) error {
+	if len(locale) > 16 || !localePattern.MatchString(locale) {
+		return errors.New("not a valid locale")
+	}
+	return nil
+}
+
 func UpdateSetEntryInputIsValid(u *model.UpdateSetEntryInput) error {
 	if u.Reps != nil && (*u.Reps > 9999 || *u.Reps < 0) {
 		return errors.New("reps needs to be between 0 and 9999")
@@ -82,8 +100,8 @@ func ExerciseRoutineIsValid(exerciseRoutine *model.ExerciseRoutine) error {
 		return errors.New("you cannot have more than 20 sets")
 	}
 
-	if len(exerciseRoutine.Name) > 32 {
-		return errors.New("exercise routine names must have less than 32 characters")
+	if err := ExerciseRoutineNameIsValid(exerciseRoutine.Name); err != nil {
+		return err
 	}
 
 	if exerciseRoutine.Reps > 99 {
@@ -93,6 +111,18 @@ func ExerciseRoutineIsValid(exerciseRoutine *model.ExerciseRoutine) error {
 	return nil
 }
 
+func ExerciseRoutineNameIsValid(name string) error {
+	if len(name) > 32 {
+		return errors.New("exercise routine names must have less than 32 characters")
+	}
+	return nil
+}
+
 func WorkoutSessionIsValid(workoutSession *model.WorkoutSession) error { return nil }
 
-func WorkoutRoutineIsValid(workoutRoutine *model.WorkoutRoutine) error { return nil }
+func WorkoutRoutineIsValid(workoutRoutine *model.WorkoutRoutine) error {
+	if len(workoutRoutine.Name) > 32 {
+		return errors.New("workout routine names must have less than 32 characters")
+	}
+	return nil
+}